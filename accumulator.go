@@ -0,0 +1,88 @@
+package parseint
+
+// Accumulator incrementally parses a base-10 integer with an optional
+// '-' or '+' sign that arrives in successive chunks, such as a Content-Length
+// value or a RESP integer read from a network connection in arbitrary pieces.
+// The zero value is an empty Accumulator ready to use.
+// An Accumulator doesn't allocate and may be reused after Reset.
+type Accumulator struct {
+	n        uint64
+	digits   bool // At least one digit was fed.
+	sign     bool // A sign was fed.
+	neg      bool
+	overflow bool // n overflowed a uint64, further digits are only consumed.
+	done     bool
+}
+
+// Feed consumes the sign and digits at the beginning of p and returns
+// the number of bytes consumed. Once a byte that can't continue the integer
+// is encountered, Feed reports done=true and n is the index of that
+// terminator byte, which isn't consumed, for example the '\r' of "34\r\n".
+// Calling Feed after it reported done has no effect.
+// Use Int64 or Uint64 to get the result either once done was reported
+// or when the input ended.
+func (a *Accumulator) Feed(p []byte) (n int, done bool) {
+	if a.done {
+		return 0, true
+	}
+	if len(p) > 0 && !a.digits && !a.sign && (p[0] == '-' || p[0] == '+') {
+		a.sign, a.neg = true, p[0] == '-'
+		n = 1
+	}
+	v := a.n
+	for ; n < len(p); n++ {
+		c := p[n]
+		if c < '0' || c > '9' {
+			a.done = true
+			break
+		}
+		a.digits = true
+		d := uint64(c - '0')
+		if a.overflow || v > (1<<64-1-d)/10 {
+			a.overflow = true
+			continue
+		}
+		v = v*10 + d
+	}
+	a.n = v
+	return n, a.done
+}
+
+// Uint64 returns the unsigned value fed so far.
+// Returns ErrSyntax if no digits were fed or if a sign was fed.
+// Returns ErrOverflow if the value overflows a uint64.
+func (a *Accumulator) Uint64() (uint64, error) {
+	switch {
+	case !a.digits || a.sign:
+		return 0, ErrSyntax
+	case a.overflow:
+		return 0, ErrOverflow
+	}
+	return a.n, nil
+}
+
+// Int64 returns the signed value fed so far.
+// Returns ErrSyntax if no digits were fed.
+// Returns ErrOverflow if the value overflows an int64.
+func (a *Accumulator) Int64() (int64, error) {
+	switch {
+	case !a.digits:
+		return 0, ErrSyntax
+	case a.overflow:
+		return 0, ErrOverflow
+	case a.neg:
+		if a.n > 1<<63 {
+			return 0, ErrOverflow
+		}
+		return int64(-a.n), nil
+	case a.n > 1<<63-1:
+		return 0, ErrOverflow
+	}
+	return int64(a.n), nil
+}
+
+// Done reports whether a terminator was encountered by Feed.
+func (a *Accumulator) Done() bool { return a.done }
+
+// Reset resets a to the zero value to accumulate a new integer.
+func (a *Accumulator) Reset() { *a = Accumulator{} }
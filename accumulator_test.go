@@ -0,0 +1,184 @@
+package parseint_test
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccumulator(t *testing.T) {
+	for _, td := range []struct {
+		input    string
+		consumed int // Index of the terminator or len(input) if not done.
+		done     bool
+		i64      int64
+		i64Err   error
+		u64      uint64
+		u64Err   error
+	}{
+		{"1234\r\n", 4, true, 1234, nil, 1234, nil},
+		{"0\r\n", 1, true, 0, nil, 0, nil},
+		{"-0\r\n", 2, true, 0, nil, 0, parseint.ErrSyntax},
+		{"-123\r\n", 4, true, -123, nil, 0, parseint.ErrSyntax},
+		{"+123 ", 4, true, 123, nil, 0, parseint.ErrSyntax},
+		{"1234", 4, false, 1234, nil, 1234, nil},
+		{"00042x", 5, true, 42, nil, 42, nil},
+		{"\r\n", 0, true, 0, parseint.ErrSyntax, 0, parseint.ErrSyntax},
+		{"", 0, false, 0, parseint.ErrSyntax, 0, parseint.ErrSyntax},
+		{"-", 1, false, 0, parseint.ErrSyntax, 0, parseint.ErrSyntax},
+		{"-\r\n", 1, true, 0, parseint.ErrSyntax, 0, parseint.ErrSyntax},
+		{"+-1", 1, true, 0, parseint.ErrSyntax, 0, parseint.ErrSyntax},
+		{"--1", 1, true, 0, parseint.ErrSyntax, 0, parseint.ErrSyntax},
+		{"1-2", 1, true, 1, nil, 1, nil},
+		{
+			"9223372036854775807\r\n", 19, true,
+			math.MaxInt64, nil, math.MaxInt64, nil,
+		},
+		{
+			"-9223372036854775808\r\n", 20, true,
+			math.MinInt64, nil, 0, parseint.ErrSyntax,
+		},
+		{
+			"9223372036854775808\r\n", 19, true,
+			0, parseint.ErrOverflow, 1 << 63, nil,
+		},
+		{
+			"-9223372036854775809\r\n", 20, true,
+			0, parseint.ErrOverflow, 0, parseint.ErrSyntax,
+		},
+		{
+			"18446744073709551615\r\n", 20, true,
+			0, parseint.ErrOverflow, math.MaxUint64, nil,
+		},
+		{
+			"18446744073709551616\r\n", 20, true,
+			0, parseint.ErrOverflow, 0, parseint.ErrOverflow,
+		},
+		{
+			"99999999999999999999999999\r\n", 26, true,
+			0, parseint.ErrOverflow, 0, parseint.ErrOverflow,
+		},
+	} {
+		// Feed the input in every possible pair of chunks.
+		for split := 0; split <= len(td.input); split++ {
+			var a parseint.Accumulator
+			n, done := a.Feed([]byte(td.input[:split]))
+			if !done {
+				var n2 int
+				n2, done = a.Feed([]byte(td.input[split:]))
+				n += n2
+			} else {
+				n2, done2 := a.Feed([]byte(td.input[split:]))
+				require.Zero(t, n2)
+				require.True(t, done2)
+			}
+			require.Equal(t, td.consumed, n, "%q split at %d", td.input, split)
+			require.Equal(t, td.done, done, "%q split at %d", td.input, split)
+			require.Equal(t, td.done, a.Done(), "%q split at %d", td.input, split)
+
+			i64, err := a.Int64()
+			require.ErrorIs(t, err, td.i64Err, "%q split at %d", td.input, split)
+			require.Equal(t, td.i64, i64, "%q split at %d", td.input, split)
+			u64, err := a.Uint64()
+			require.ErrorIs(t, err, td.u64Err, "%q split at %d", td.input, split)
+			require.Equal(t, td.u64, u64, "%q split at %d", td.input, split)
+		}
+	}
+}
+
+func TestAccumulatorOneByte(t *testing.T) {
+	var a parseint.Accumulator
+	input := []byte("-12345678901234\r\n")
+	i := 0
+	for ; i < len(input); i++ {
+		if n, done := a.Feed(input[i : i+1]); done {
+			require.Zero(t, n)
+			break
+		}
+	}
+	require.Equal(t, strings.Index(string(input), "\r"), i)
+	v, err := a.Int64()
+	require.NoError(t, err)
+	require.Equal(t, int64(-12345678901234), v)
+
+	a.Reset()
+	require.False(t, a.Done())
+	_, err = a.Int64()
+	require.ErrorIs(t, err, parseint.ErrSyntax)
+	n, done := a.Feed([]byte("7\r\n"))
+	require.Equal(t, 1, n)
+	require.True(t, done)
+	v, err = a.Int64()
+	require.NoError(t, err)
+	require.Equal(t, int64(7), v)
+}
+
+func TestAccumulatorAllocs(t *testing.T) {
+	input := []byte("-1234567890\r\n")
+	var a parseint.Accumulator
+	allocs := testing.AllocsPerRun(100, func() {
+		a.Reset()
+		a.Feed(input[:3])
+		a.Feed(input[3:])
+		if _, err := a.Int64(); err != nil {
+			panic(err)
+		}
+	})
+	require.Zero(t, allocs)
+}
+
+func FuzzAccumulator(f *testing.F) {
+	for _, s := range []string{
+		"0", "-1", "+1", "1234\r\n", "9223372036854775808\r\n",
+		"18446744073709551616", "-", "x", "",
+	} {
+		f.Add(s, int64(1))
+	}
+
+	f.Fuzz(func(t *testing.T, s string, seed int64) {
+		// Feed s in random chunks.
+		r := rand.New(rand.NewSource(seed))
+		var a parseint.Accumulator
+		consumed, done := 0, false
+		for rest := []byte(s); len(rest) > 0 && !done; {
+			chunk := rest[:r.Intn(len(rest))+1]
+			var n int
+			n, done = a.Feed(chunk)
+			consumed += n
+			rest = rest[n:]
+			if !done && n != len(chunk) {
+				t.Fatalf("%q: consumed %d of %d without being done", s, n, len(chunk))
+			}
+		}
+
+		// The consumed prefix must be the longest valid integer prefix.
+		token := s[:consumed]
+		if done && consumed < len(s) {
+			c := s[consumed]
+			if c >= '0' && c <= '9' {
+				t.Fatalf("%q: terminated at digit %d", s, consumed)
+			}
+		}
+		v, err := a.Int64()
+		std, errStd := strconv.ParseInt(token, 10, 64)
+		switch {
+		case errStd == nil:
+			if err != nil || v != std {
+				t.Fatalf("%q: expected %d; received: %d, %v", token, std, v, err)
+			}
+		case strings.Contains(errStd.Error(), "out of range"):
+			if err != parseint.ErrOverflow || v != 0 {
+				t.Fatalf("%q: expected ErrOverflow; received: %d, %v", token, v, err)
+			}
+		default:
+			if err != parseint.ErrSyntax || v != 0 {
+				t.Fatalf("%q: expected ErrSyntax; received: %d, %v", token, v, err)
+			}
+		}
+	})
+}
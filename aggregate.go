@@ -0,0 +1,45 @@
+package parseint
+
+// Aggregate holds the aggregates of a list of integers.
+// Min and Max are zero for an empty list.
+type Aggregate[T ~int64 | ~uint64] struct {
+	Count         int
+	Sum, Min, Max T
+}
+
+// AggregateList computes the aggregates of the separated list of items in s
+// on the fly without materializing the values, using parse to parse the items
+// and the same tokenization as AppendList.
+// Returns *ListError if any item fails to parse, if there are more than
+// opts.MaxItems items or wrapping ErrOverflow if the sum overflows T.
+func AggregateList[S string | []byte, T ~int64 | ~uint64](
+	s S, opts ListOptions, parse func(S) (T, error),
+) (Aggregate[T], error) {
+	var a Aggregate[T]
+	t := newListTokenizer(s, opts)
+	for {
+		item, index, ok := t.next()
+		if !ok {
+			return a, nil
+		}
+		if opts.MaxItems > 0 && a.Count >= opts.MaxItems {
+			return Aggregate[T]{}, &ListError{Index: index, Err: ErrTooManyItems}
+		}
+		v, err := parse(item)
+		if err != nil {
+			return Aggregate[T]{}, &ListError{Index: index, Err: err}
+		}
+		sum := a.Sum + v
+		if (v >= 0 && sum < a.Sum) || (v < 0 && sum > a.Sum) { // Wrapped around.
+			return Aggregate[T]{}, &ListError{Index: index, Err: ErrOverflow}
+		}
+		if a.Count == 0 || v < a.Min {
+			a.Min = v
+		}
+		if a.Count == 0 || v > a.Max {
+			a.Max = v
+		}
+		a.Sum = sum
+		a.Count++
+	}
+}
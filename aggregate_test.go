@@ -0,0 +1,186 @@
+package parseint_test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateList(t *testing.T) {
+	type aggInput struct {
+		Input string
+		Opts  parseint.ListOptions
+	}
+	for input, expect := range map[aggInput]parseint.Aggregate[int64]{
+		{"", parseint.ListOptions{}}:  {},
+		{"5", parseint.ListOptions{}}: {Count: 1, Sum: 5, Min: 5, Max: 5},
+		{"4,8,15,16,23,42", parseint.ListOptions{}}: {
+			Count: 6, Sum: 108, Min: 4, Max: 42,
+		},
+		{"-1, 3 ,-7,2", parseint.ListOptions{TrimSpace: true}}: {
+			Count: 4, Sum: -3, Min: -7, Max: 3,
+		},
+		{"1,,2,", parseint.ListOptions{SkipEmpty: true}}: {
+			Count: 2, Sum: 3, Min: 1, Max: 2,
+		},
+		{"9223372036854775807,-1,1", parseint.ListOptions{}}: {
+			Count: 3, Sum: math.MaxInt64, Min: -1, Max: math.MaxInt64,
+		},
+		{"-9223372036854775808,1,-1", parseint.ListOptions{}}: {
+			Count: 3, Sum: math.MinInt64, Min: math.MinInt64, Max: 1,
+		},
+	} {
+		actual, err := parseint.AggregateList(input.Input, input.Opts,
+			parseint.Base10Int64[string])
+		require.NoError(t, err, "%#v", input)
+		require.Equal(t, expect, actual, "%#v", input)
+
+		actual, err = parseint.AggregateList([]byte(input.Input), input.Opts,
+			parseint.Base10Int64[[]byte])
+		require.NoError(t, err, "%#v", input)
+		require.Equal(t, expect, actual, "%#v", input)
+	}
+
+	for input, expect := range map[aggInput]parseint.ListError{
+		{"1,x", parseint.ListOptions{}}:  {Index: 1, Err: parseint.ErrSyntax},
+		{"1,,2", parseint.ListOptions{}}: {Index: 1, Err: parseint.ErrSyntax},
+		{"9223372036854775807,1", parseint.ListOptions{}}: {
+			Index: 1, Err: parseint.ErrOverflow,
+		},
+		{"-9223372036854775808,-1", parseint.ListOptions{}}: {
+			Index: 1, Err: parseint.ErrOverflow,
+		},
+		{"1,2,9223372036854775808", parseint.ListOptions{}}: {
+			Index: 2, Err: parseint.ErrOverflow,
+		},
+		{"1,2,3", parseint.ListOptions{MaxItems: 2}}: {
+			Index: 2, Err: parseint.ErrTooManyItems,
+		},
+	} {
+		actual, err := parseint.AggregateList(input.Input, input.Opts,
+			parseint.Base10Int64[string])
+		var le *parseint.ListError
+		require.True(t, errors.As(err, &le), "%#v", input)
+		require.Equal(t, expect, *le, "%#v", input)
+		require.Zero(t, actual, "%#v", input)
+	}
+}
+
+func TestAggregateListUint64(t *testing.T) {
+	actual, err := parseint.AggregateList("ff 1 0", parseint.ListOptions{Sep: ' '},
+		parseint.Base16Uint32[string, uint64])
+	require.NoError(t, err)
+	require.Equal(t, parseint.Aggregate[uint64]{
+		Count: 3, Sum: 0x100, Min: 0, Max: 0xff,
+	}, actual)
+
+	actual, err = parseint.AggregateList("18446744073709551615,0",
+		parseint.ListOptions{}, parseint.Base10Uint64[string])
+	require.NoError(t, err)
+	require.Equal(t, parseint.Aggregate[uint64]{
+		Count: 2, Sum: math.MaxUint64, Min: 0, Max: math.MaxUint64,
+	}, actual)
+
+	_, err = parseint.AggregateList("18446744073709551615,1",
+		parseint.ListOptions{}, parseint.Base10Uint64[string])
+	require.ErrorIs(t, err, parseint.ErrOverflow)
+	require.Equal(t, "item 1: overflow", err.Error())
+}
+
+func TestAggregateListAllocs(t *testing.T) {
+	input := strings.Repeat("12345,-67890,", 100) + "0"
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := parseint.AggregateList(input, parseint.ListOptions{},
+			parseint.Base10Int64[string]); err != nil {
+			panic(err)
+		}
+	})
+	require.Zero(t, allocs)
+}
+
+func FuzzAggregateList(f *testing.F) {
+	for _, s := range []string{
+		"", "1", "1,2,3", "-1,1", "9223372036854775807,1",
+		"-9223372036854775808,-1", "x",
+	} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		actual, err := parseint.AggregateList(s, parseint.ListOptions{},
+			parseint.Base10Int64[string])
+
+		values, errList := parseint.AppendList(nil, s, parseint.ListOptions{},
+			parseint.Base10Int64[string])
+		if errList != nil {
+			if err == nil || err.Error() != errList.Error() {
+				t.Fatalf("%q: expected error %v; received: %v", s, errList, err)
+			}
+			return
+		}
+
+		// Reference using math/big to detect overflow of the sum.
+		var expect parseint.Aggregate[int64]
+		sum := new(big.Int)
+		for i, v := range values {
+			sum.Add(sum, big.NewInt(v))
+			if !sum.IsInt64() {
+				if err == nil || err.Error() != "item "+strconv.Itoa(i)+": overflow" {
+					t.Fatalf("%q: expected overflow at %d; received: %v", s, i, err)
+				}
+				return
+			}
+			if i == 0 || v < expect.Min {
+				expect.Min = v
+			}
+			if i == 0 || v > expect.Max {
+				expect.Max = v
+			}
+			expect.Count++
+		}
+		expect.Sum = sum.Int64()
+		if err != nil || actual != expect {
+			t.Fatalf("%q: expected %#v; received: %#v, %v", s, expect, actual, err)
+		}
+	})
+}
+
+func BenchmarkAggregateList(b *testing.B) {
+	input := strings.Repeat("4,8,15,16,23,42,", 100) + "108"
+	var a parseint.Aggregate[int64]
+	var err error
+
+	fn := getBenchmarkImpl(b, func(s string) (parseint.Aggregate[int64], error) {
+		var a parseint.Aggregate[int64]
+		for _, item := range strings.Split(s, ",") {
+			v, err := strconv.ParseInt(item, 10, 64)
+			if err != nil {
+				return parseint.Aggregate[int64]{}, err
+			}
+			if a.Count == 0 || v < a.Min {
+				a.Min = v
+			}
+			if a.Count == 0 || v > a.Max {
+				a.Max = v
+			}
+			a.Sum += v
+			a.Count++
+		}
+		return a, nil
+	}, func(s string) (parseint.Aggregate[int64], error) {
+		return parseint.AggregateList(s, parseint.ListOptions{},
+			parseint.Base10Int64[string])
+	})
+	for n := 0; n < b.N; n++ {
+		a, err = fn(input)
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
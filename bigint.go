@@ -0,0 +1,127 @@
+package parseint
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// Base10BigInt parses s as a base-10 arbitrary-precision signed integer.
+// Returns ErrSyntax if s contains an invalid character.
+// Base10BigInt is comparable to new(big.Int).SetString(s, 10) but is more
+// efficient, especially for very long inputs, since it parses 19 digits at a
+// time into 64-bit limbs using Base10Uint64 and combines the limbs using
+// a divide-and-conquer strategy instead of multiplying digit by digit.
+func Base10BigInt[S string | []byte](s S) (*big.Int, error) {
+	if len(s) == 0 {
+		return nil, ErrSyntax
+	}
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	if len(s) == 0 { // Sign without any following digits.
+		return nil, ErrSyntax
+	}
+
+	// Split s into limbs of 19 digits, the most significant limb first.
+	// The first limb holds the remaining len(s)%19 digits.
+	limbs := make([]uint64, (len(s)+bigLimbDigits-1)/bigLimbDigits)
+	first := len(s) % bigLimbDigits
+	if first == 0 {
+		first = bigLimbDigits
+	}
+	for i := range limbs {
+		l, err := Base10Uint64(s[:first])
+		if err != nil {
+			return nil, ErrSyntax // Can't overflow with at most 19 digits.
+		}
+		limbs[i] = l
+		s = s[first:]
+		first = bigLimbDigits
+	}
+
+	var z *big.Int
+	if len(limbs) <= bigLimbsLinear {
+		z = natToBig(natFromLimbs(limbs))
+	} else {
+		z = bigFromLimbs(limbs, bigLimbPowers(len(limbs)))
+	}
+	if neg {
+		z.Neg(z)
+	}
+	return z, nil
+}
+
+const (
+	// bigLimbDigits is the number of decimal digits per limb.
+	bigLimbDigits = 19
+
+	// bigLimb is the base of a limb (10^bigLimbDigits).
+	bigLimb = 10_000_000_000_000_000_000
+
+	// bigLimbsLinear is the number of limbs up to which the limbs are combined
+	// in quadratic time, which is faster than divide-and-conquer for short inputs.
+	bigLimbsLinear = 32
+)
+
+// bigFromLimbs combines the base-10^19 limbs (most significant first)
+// recursively by splitting them into a high and a low part such that
+// the value is hi * 10^(19*k) + lo, where k is the largest power of two
+// smaller than len(limbs). pow[i] must be 10^(19*2^i).
+func bigFromLimbs(limbs []uint64, pow []*big.Int) *big.Int {
+	if len(limbs) <= bigLimbsLinear {
+		return natToBig(natFromLimbs(limbs))
+	}
+	i := bits.Len(uint(len(limbs)-1)) - 1
+	k := 1 << i
+	hi := bigFromLimbs(limbs[:len(limbs)-k], pow)
+	lo := bigFromLimbs(limbs[len(limbs)-k:], pow)
+	hi.Mul(hi, pow[i])
+	return hi.Add(hi, lo)
+}
+
+// bigLimbPowers returns the powers 10^(19*2^i) required by bigFromLimbs
+// for n limbs.
+func bigLimbPowers(n int) []*big.Int {
+	pow := make([]*big.Int, bits.Len(uint(n-1)))
+	pow[0] = new(big.Int).SetUint64(bigLimb)
+	for i := 1; i < len(pow); i++ {
+		pow[i] = new(big.Int).Mul(pow[i-1], pow[i-1])
+	}
+	return pow
+}
+
+// natFromLimbs returns the little-endian base-2^64 representation
+// of the base-10^19 limbs (most significant first).
+func natFromLimbs(limbs []uint64) []uint64 {
+	z := make([]uint64, 0, len(limbs))
+	for _, l := range limbs {
+		carry := l
+		for i := range z { // z = z*10^19 + l
+			hi, lo := bits.Mul64(z[i], bigLimb)
+			var c uint64
+			z[i], c = bits.Add64(lo, carry, 0)
+			carry = hi + c
+		}
+		if carry != 0 {
+			z = append(z, carry)
+		}
+	}
+	return z
+}
+
+// natToBig converts the little-endian base-2^64 number z to a big.Int.
+func natToBig(z []uint64) *big.Int {
+	const wordsPerLimb = 64 / bits.UintSize
+	w := make([]big.Word, len(z)*wordsPerLimb)
+	for i, l := range z {
+		for j := 0; j < wordsPerLimb; j++ {
+			w[i*wordsPerLimb+j] = big.Word(l >> (j * bits.UintSize))
+		}
+	}
+	return new(big.Int).SetBits(w)
+}
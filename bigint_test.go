@@ -0,0 +1,150 @@
+package parseint_test
+
+import (
+	"math/big"
+	"math/rand"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+var validBase10BigInt = []string{
+	"0",
+	"-0",
+	"+0",
+	"1",
+	"-1",
+	"0000000000000000000000000000000000000000001",
+	"9999999999999999999",
+	"10000000000000000000",
+	"18446744073709551615",
+	"18446744073709551616",
+	"-340282366920938463463374607431768211456",
+	"123456789012345678901234567890123456789012345678901234567890",
+	strings.Repeat("9", 19*32),
+	strings.Repeat("9", 19*32+1),
+	strings.Repeat("1", 10_000),
+	"-" + strings.Repeat("7", 4_321),
+}
+
+var invalidBase10BigInt = []string{
+	"",
+	"-",
+	"+",
+	"--1",
+	"+-1",
+	" 1",
+	"1 ",
+	"1_000",
+	"0x10",
+	"1.0",
+	"1e10",
+	"ж",
+	strings.Repeat("1", 1_000) + "x",
+	"x" + strings.Repeat("1", 1_000),
+}
+
+func TestBase10BigInt(t *testing.T) {
+	requireOK := func(t *testing.T, input string) {
+		expect, ok := new(big.Int).SetString(input, 10)
+		require.True(t, ok, "%q", input)
+		actual, err := parseint.Base10BigInt(input)
+		require.NoError(t, err, "%q", input)
+		require.Zero(t, expect.Cmp(actual), "%q", input)
+		actual, err = parseint.Base10BigInt([]byte(input))
+		require.NoError(t, err, "%q", input)
+		require.Zero(t, expect.Cmp(actual), "%q", input)
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for _, input := range validBase10BigInt {
+			requireOK(t, input)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, input := range invalidBase10BigInt {
+			actual, err := parseint.Base10BigInt(input)
+			require.ErrorIs(t, err, parseint.ErrSyntax, "%q", input)
+			require.Nil(t, actual)
+			actual, err = parseint.Base10BigInt([]byte(input))
+			require.ErrorIs(t, err, parseint.ErrSyntax, "%q", input)
+			require.Nil(t, actual)
+		}
+	})
+
+	t.Run("random_lengths", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		for length := 1; length <= 3_000; length += 1 + length/16 {
+			var b strings.Builder
+			for i := 0; i < length; i++ {
+				b.WriteByte(byte('0' + r.Intn(10)))
+			}
+			requireOK(t, b.String())
+			requireOK(t, "-"+b.String())
+		}
+	})
+}
+
+func FuzzBase10BigInt(f *testing.F) {
+	for _, input := range validBase10BigInt {
+		f.Add(input)
+	}
+	for _, input := range invalidBase10BigInt {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		x, err := parseint.Base10BigInt(s)
+		std, ok := new(big.Int).SetString(s, 10)
+		if err == nil {
+			if !ok {
+				t.Fatalf("must have returned error but didn't: %q", s)
+			} else if std.Cmp(x) != 0 {
+				t.Errorf("expected %s; received: %s", std, x)
+			}
+		} else {
+			if x != nil {
+				t.Errorf("%q: failed but returned non-nil value: %s", s, x)
+			}
+			if ok {
+				t.Fatalf("unexpected error for input %q: %v", s, err)
+			}
+		}
+	})
+}
+
+func BenchmarkBase10BigInt(b *testing.B) {
+	fn := getBenchmarkFn(b, func(s string) (*big.Int, error) {
+		x, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, parseint.ErrSyntax
+		}
+		return x, nil
+	}, parseint.Base10BigInt[string])
+
+	var a *big.Int
+	var err error
+	for _, td := range []struct {
+		name  string
+		input string
+	}{
+		{"d20", strings.Repeat("9", 20)},
+		{"d100", strings.Repeat("9", 100)},
+		{"d1000", strings.Repeat("9", 1_000)},
+		{"d10000", strings.Repeat("9", 10_000)},
+		{"d100000", strings.Repeat("9", 100_000)},
+		{"syntax", strings.Repeat("9", 99) + "x"},
+	} {
+		b.Run(td.name+"/string", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				a, err = fn(td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
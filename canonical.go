@@ -0,0 +1,89 @@
+package parseint
+
+import "unicode/utf8"
+
+// Canonical parses s using parse after making sure s is in canonical form,
+// such that every value has exactly one accepted textual representation:
+// leading zeroes (except for a single "0"), a '+' sign and "-0" are rejected
+// with ErrSyntax. Other errors are returned by parse unchanged.
+// This includes the multi-byte signs accepted by Base10Int64LenientSign,
+// such as "−0" and "＋1", and the zero digits of all Unicode decimal digit
+// blocks accepted by Base10Int64Unicode, such as "０１２".
+// A zero is only considered leading if it's followed by a decimal digit
+// of the same script, so prefixed inputs such as "0x1F" and "0o17" and
+// fractions such as "0.5" are left to parse. Use CanonicalHex for parsers
+// of unprefixed hexadecimal input such as Base16Uint16 and Base16Uint32.
+// Surrounding ASCII whitespace is ignored, so Canonical may wrap TrimSpace.
+//
+//	v, err := parseint.Canonical("0012", parseint.Base10Uint64[string]) // ErrSyntax
+func Canonical[S string | []byte, T any](s S, parse func(S) (T, error)) (T, error) {
+	if !isCanonical(s, false) {
+		var zero T
+		return zero, ErrSyntax
+	}
+	return parse(s)
+}
+
+// CanonicalHex is similar to Canonical but for unprefixed hexadecimal input,
+// a zero followed by any hexadecimal digit is considered leading.
+//
+//	v, err := parseint.CanonicalHex("0ff", parseint.Base16Uint16[string, uint16]) // ErrSyntax
+func CanonicalHex[S string | []byte, T any](s S, parse func(S) (T, error)) (T, error) {
+	if !isCanonical(s, true) {
+		var zero T
+		return zero, ErrSyntax
+	}
+	return parse(s)
+}
+
+// isCanonical returns false if s has a plus sign, is negative zero or
+// has leading zeroes, otherwise returns true.
+// Plus and minus signs include the multi-byte variants recognized
+// by lenientSign and zeroes include the zero of any Unicode Nd block.
+// If hex is true an ASCII zero followed by any hexadecimal digit is leading.
+func isCanonical[S string | []byte](s S, hex bool) bool {
+	s = trimSpace(s)
+	if len(s) == 0 {
+		return true // Let the parser handle empty input.
+	}
+	neg := false
+	switch {
+	case s[0] == '+':
+		return false
+	case s[0] == '-':
+		neg, s = true, s[1:]
+	case s[0] >= utf8.RuneSelf:
+		if n, size := lenientSign(s); size > 0 {
+			if !n {
+				return false
+			}
+			neg, s = true, s[size:]
+		}
+	}
+	if len(s) == 0 {
+		return true // Let the parser handle a sign without digits.
+	}
+	var zero rune
+	zeroSize := 0
+	switch {
+	case s[0] == '0':
+		zero, zeroSize = '0', 1
+	case s[0] >= utf8.RuneSelf:
+		r, size := decodeRune(s)
+		if z, ok := unicodeDigitZero(r); ok && z == r {
+			zero, zeroSize = z, size
+		}
+	}
+	if zeroSize == 0 {
+		return true
+	}
+	if len(s) == zeroSize {
+		return !neg // A zero is only canonical without a minus sign.
+	}
+	if hex && zeroSize == 1 && lutHex[s[1]] != invalidHexByte {
+		return false
+	}
+	// A zero followed by a digit of the same script is a leading zero.
+	r, _ := decodeRune(s[zeroSize:])
+	return r < zero || r > zero+9
+}
@@ -0,0 +1,187 @@
+package parseint_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+var validCanonicalBase10Int64 = map[string]int64{
+	"0":                    0,
+	"1":                    1,
+	"-1":                   -1,
+	"10":                   10,
+	"-10":                  -10,
+	"1000000":              1000000,
+	"9223372036854775807":  math.MaxInt64,
+	"-9223372036854775808": math.MinInt64,
+}
+
+var invalidCanonicalBase10Int64 = map[string]error{
+	"":                     parseint.ErrSyntax,
+	"-":                    parseint.ErrSyntax,
+	"+":                    parseint.ErrSyntax,
+	"+0":                   parseint.ErrSyntax,
+	"+1":                   parseint.ErrSyntax,
+	"-0":                   parseint.ErrSyntax,
+	"-00":                  parseint.ErrSyntax,
+	"-01":                  parseint.ErrSyntax,
+	"00":                   parseint.ErrSyntax,
+	"01":                   parseint.ErrSyntax,
+	"0012":                 parseint.ErrSyntax,
+	"0x12":                 parseint.ErrSyntax,
+	" 12":                  parseint.ErrSyntax,
+	" 012":                 parseint.ErrSyntax,
+	"012 ":                 parseint.ErrSyntax,
+	"\t-0":                 parseint.ErrSyntax,
+	"0-1":                  parseint.ErrSyntax,
+	"--12":                 parseint.ErrSyntax,
+	"12a":                  parseint.ErrSyntax,
+	"09223372036854775807": parseint.ErrSyntax,
+
+	"9223372036854775808":  parseint.ErrOverflow,
+	"-9223372036854775809": parseint.ErrOverflow,
+}
+
+func TestCanonical(t *testing.T) {
+	callCanonical := func(input string, fn func(int64, error)) {
+		fn(parseint.Canonical(input, parseint.Base10Int64[string]))
+		fn(parseint.Canonical([]byte(input), parseint.Base10Int64[[]byte]))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range validCanonicalBase10Int64 {
+			callCanonical(input, func(actual int64, err error) {
+				require.NoError(t, err, "%q", input)
+				require.Equal(t, expect, actual, "%q", input)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range invalidCanonicalBase10Int64 {
+			callCanonical(input, func(a int64, err error) {
+				require.ErrorIs(t, err, expectedErr, "%q", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+
+	t.Run("all_parsers", func(t *testing.T) {
+		for input, expectOK := range map[string]bool{
+			"0": true, "7": true, "10": true, "ff": true,
+			"00": false, "07": false, "0ff": false, "+7": false, "-0": false,
+		} {
+			_, err := parseint.CanonicalHex(input, parseint.Base16Uint16[string, uint16])
+			require.Equal(t, expectOK, err == nil, "%q", input)
+			_, err = parseint.CanonicalHex(input, parseint.Base16Uint32[string, uint32])
+			require.Equal(t, expectOK, err == nil, "%q", input)
+			if input == "ff" || input == "0ff" {
+				continue
+			}
+			_, err = parseint.Canonical(input, parseint.Base10Uint32[string, uint32])
+			require.Equal(t, expectOK, err == nil, "%q", input)
+			_, err = parseint.Canonical(input, parseint.Base10Int32[string, int32])
+			require.Equal(t, expectOK, err == nil, "%q", input)
+			_, err = parseint.Canonical(input, parseint.Base10Uint64[string])
+			require.Equal(t, expectOK, err == nil, "%q", input)
+		}
+	})
+
+	t.Run("hex", func(t *testing.T) {
+		for input, expectOK := range map[string]bool{
+			"0": true, "f": true, "ff": true, "100": true, "ABC": true,
+			"0f": false, "0ff": false, "0abc": false, "0F": false, "00": false,
+			"0b1": false, "01": false,
+		} {
+			_, err := parseint.CanonicalHex(input, parseint.Base16Uint16[string, uint16])
+			require.Equal(t, expectOK, err == nil, "%q", input)
+			_, err = parseint.CanonicalHex([]byte(input),
+				parseint.Base16Uint32[[]byte, uint32])
+			require.Equal(t, expectOK, err == nil, "%q", input)
+		}
+	})
+
+	t.Run("unicode", func(t *testing.T) {
+		for input, expectOK := range map[string]bool{
+			"−1": true, "−10": true, "−0": false, "−01": false,
+			"﹣0": false, "－0": false, "＋1": false, "﹢1": false,
+		} {
+			_, err := parseint.Canonical(input, parseint.Base10Int64LenientSign[string])
+			require.Equal(t, expectOK, err == nil, "%q", input)
+			_, err = parseint.Canonical([]byte(input),
+				parseint.Base10Int64LenientSign[[]byte])
+			require.Equal(t, expectOK, err == nil, "%q", input)
+		}
+		for input, expectOK := range map[string]bool{
+			"０": true, "１２": true, "-１０": true, "١٠": true,
+			"０１２": false, "-０": false, "+１": false, "٠١": false, "००": false,
+		} {
+			_, err := parseint.Canonical(input, parseint.Base10Int64Unicode[string])
+			require.Equal(t, expectOK, err == nil, "%q", input)
+			_, err = parseint.Canonical([]byte(input), parseint.Base10Int64Unicode[[]byte])
+			require.Equal(t, expectOK, err == nil, "%q", input)
+		}
+	})
+
+	t.Run("prefixed", func(t *testing.T) {
+		for input, expect := range map[string]int64{
+			"0x1F": 0x1f, "0o17": 0o17, "0b101": 0b101, "0": 0,
+		} {
+			v, err := parseint.Canonical(input, parseint.TOMLInt64[string])
+			require.NoError(t, err, "%q", input)
+			require.Equal(t, expect, v, "%q", input)
+		}
+		for _, input := range []string{"00x1F", "-0x1F", "+0o17", "01", "-0"} {
+			_, err := parseint.Canonical(input, parseint.TOMLInt64[string])
+			require.ErrorIs(t, err, parseint.ErrSyntax, "%q", input)
+		}
+	})
+
+	t.Run("trim_space", func(t *testing.T) {
+		for input, expectOK := range map[string]bool{
+			" 12 ": true, "\t0\n": true, " -1": true,
+			" 012": false, "012 ": false, " +1": false, " -0 ": false,
+		} {
+			_, err := parseint.Canonical(input, func(s string) (int64, error) {
+				return parseint.TrimSpace(s, parseint.Base10Int64[string])
+			})
+			require.Equal(t, expectOK, err == nil, "%q", input)
+		}
+	})
+
+	t.Run("range_10k", func(t *testing.T) {
+		for i := int64(-10_000); i <= 10_000; i++ {
+			callCanonical(strconv.FormatInt(i, 10), func(actual int64, err error) {
+				require.NoError(t, err)
+				require.Equal(t, i, actual)
+			})
+		}
+	})
+}
+
+func FuzzCanonical(f *testing.F) {
+	for input := range validCanonicalBase10Int64 {
+		f.Add(input)
+	}
+	for input := range invalidCanonicalBase10Int64 {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		x, err := parseint.Canonical(s, parseint.Base10Int64[string])
+		if err != nil {
+			if x != 0 {
+				t.Errorf("%q: failed but returned non-zero value: %x", s, x)
+			}
+			return
+		}
+		// The canonical representation is the one produced by strconv.
+		if std := strconv.FormatInt(x, 10); std != s {
+			t.Fatalf("must have returned error but didn't: %q (canonical: %q)", s, std)
+		}
+	})
+}
@@ -0,0 +1,216 @@
+package parseint
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+)
+
+var (
+	// ErrMissingColumn is returned by CSVScanner if a record
+	// has fewer fields than required by the selected columns.
+	ErrMissingColumn = errors.New("missing column")
+
+	// ErrInvalidColumn is returned by NewCSVScanner if a column is negative.
+	ErrInvalidColumn = errors.New("invalid column")
+)
+
+// CSVError records the row and the column of a CSV field that failed to parse.
+type CSVError struct {
+	Row    int   // 1-based record number including skipped records.
+	Column int   // 0-based column index.
+	Err    error // ErrSyntax, ErrOverflow or ErrMissingColumn.
+}
+
+func (e *CSVError) Error() string {
+	return "row " + strconv.Itoa(e.Row) + ", column " + strconv.Itoa(e.Column) +
+		": " + e.Err.Error()
+}
+
+func (e *CSVError) Unwrap() error { return e.Err }
+
+// CSVScanner extracts integers from selected columns of CSV records
+// as defined by RFC 4180 without allocating per record.
+// Fields may be quoted, for example "42", and quoted fields may contain
+// separators, newlines and escaped quotes (""), which is only valid for
+// fields that aren't selected since it's not an integer.
+// Records are terminated by "\n" or "\r\n" and empty lines are skipped.
+// Unlike encoding/csv the number of fields per record may vary as long as
+// all selected columns are present.
+type CSVScanner[S string | []byte] struct {
+	// Comma is the field separator, ',' by default.
+	Comma byte
+
+	s      S
+	sel    []csvColumn // Selected columns sorted by column.
+	fields []S         // Selected fields of the current record.
+	cols   []int
+	row    int
+	err    error // Sticky quoting error.
+}
+
+// csvColumn maps a selected column to its index in the selection.
+type csvColumn struct{ column, index int }
+
+// NewCSVScanner returns a new CSVScanner reading the records in s
+// and extracting the given 0-based columns.
+// A column may be selected more than once.
+// Returns ErrInvalidColumn if any column is negative.
+func NewCSVScanner[S string | []byte](s S, columns ...int) (*CSVScanner[S], error) {
+	sel := make([]csvColumn, len(columns))
+	for i, c := range columns {
+		if c < 0 {
+			return nil, ErrInvalidColumn
+		}
+		sel[i] = csvColumn{column: c, index: i}
+	}
+	slices.SortStableFunc(sel, func(a, b csvColumn) int { return a.column - b.column })
+	return &CSVScanner[S]{
+		Comma:  ',',
+		s:      s,
+		sel:    sel,
+		fields: make([]S, len(columns)),
+		cols:   append([]int(nil), columns...),
+	}, nil
+}
+
+// Row returns the 1-based number of the last scanned record.
+func (c *CSVScanner[S]) Row() int { return c.row }
+
+// ScanInt64 scans the next record and parses the selected columns
+// using Base10Int64 into dst, which must have the length of the columns.
+// dst[i] is the value of the i-th selected column.
+// Returns io.EOF if there are no more records.
+// Returns *CSVError if a selected field fails to parse or is missing,
+// in which case the remaining fields of the record are skipped.
+// Returns *CSVError wrapping ErrSyntax for malformed quoting,
+// after which CSVScanner stops scanning and keeps returning this error.
+func (c *CSVScanner[S]) ScanInt64(dst []int64) error {
+	if err := c.scan(); err != nil {
+		return err
+	}
+	for i, f := range c.fields {
+		v, err := Base10Int64(f)
+		if err != nil {
+			return &CSVError{Row: c.row, Column: c.cols[i], Err: err}
+		}
+		dst[i] = v
+	}
+	return nil
+}
+
+// ScanUint64 is similar to ScanInt64 but parses using Base10Uint64.
+func (c *CSVScanner[S]) ScanUint64(dst []uint64) error {
+	if err := c.scan(); err != nil {
+		return err
+	}
+	for i, f := range c.fields {
+		v, err := Base10Uint64(f)
+		if err != nil {
+			return &CSVError{Row: c.row, Column: c.cols[i], Err: err}
+		}
+		dst[i] = v
+	}
+	return nil
+}
+
+// Skip skips the next record, for example the header.
+// Returns io.EOF if there are no more records.
+func (c *CSVScanner[S]) Skip() error {
+	err := c.scan()
+	var e *CSVError
+	if errors.As(err, &e) && e.Err == ErrMissingColumn {
+		return nil
+	}
+	return err
+}
+
+// scan reads the next record and collects its selected fields.
+func (c *CSVScanner[S]) scan() error {
+	if c.err != nil {
+		return c.err
+	}
+	for len(c.s) > 0 && (c.s[0] == '\n' || // Skip empty lines.
+		(c.s[0] == '\r' && (len(c.s) == 1 || c.s[1] == '\n'))) {
+		if c.s[0] == '\r' && len(c.s) > 1 {
+			c.s = c.s[1:]
+		}
+		c.s = c.s[1:]
+	}
+	if len(c.s) == 0 {
+		return io.EOF
+	}
+	c.row++
+	k := 0 // Index of the next selected column in c.sel.
+	for col, end := 0, false; !end; col++ {
+		var f S
+		var err error
+		f, end, err = c.field()
+		if err != nil {
+			c.err = &CSVError{Row: c.row, Column: col, Err: err}
+			return c.err
+		}
+		for ; k < len(c.sel) && c.sel[k].column == col; k++ {
+			c.fields[c.sel[k].index] = f
+		}
+	}
+	if k < len(c.sel) {
+		return &CSVError{Row: c.row, Column: c.sel[k].column, Err: ErrMissingColumn}
+	}
+	return nil
+}
+
+// field consumes the next field including its separator
+// and reports whether it's the last field of the record.
+// Returns ErrSyntax if the field is quoted incorrectly.
+func (c *CSVScanner[S]) field() (f S, end bool, err error) {
+	s := c.s
+	if len(s) > 0 && s[0] == '"' {
+		i := 1
+		for {
+			for i < len(s) && s[i] != '"' {
+				i++
+			}
+			if i >= len(s) { // Missing closing quote.
+				return f, true, ErrSyntax
+			}
+			if i+1 < len(s) && s[i+1] == '"' { // Escaped quote.
+				i += 2
+				continue
+			}
+			break
+		}
+		f, s = s[1:i], s[i+1:]
+		switch {
+		case len(s) == 0:
+			c.s = s
+			return f, true, nil
+		case s[0] == c.Comma:
+			c.s = s[1:]
+			return f, false, nil
+		case s[0] == '\n':
+			c.s = s[1:]
+			return f, true, nil
+		case s[0] == '\r' && (len(s) == 1 || s[1] == '\n'):
+			c.s = s[min(2, len(s)):]
+			return f, true, nil
+		}
+		return f, true, ErrSyntax // Unexpected character after closing quote.
+	}
+
+	i := 0
+	for i < len(s) && s[i] != c.Comma && s[i] != '\n' {
+		i++
+	}
+	f = s[:i]
+	if i < len(s) && s[i] == c.Comma {
+		c.s = s[i+1:]
+		return f, false, nil
+	}
+	c.s = s[min(i+1, len(s)):]
+	if len(f) > 0 && f[len(f)-1] == '\r' { // Also at the end of s.
+		f = f[:len(f)-1]
+	}
+	return f, true, nil
+}
@@ -0,0 +1,259 @@
+package parseint_test
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVScanner(t *testing.T) {
+	const input = "id,name,count,note\r\n" +
+		"1,alice,42,\"hello, world\"\r\n" +
+		"\"2\",\"bob \"\"b\"\"\",\"-7\",\"multi\nline\"\n" +
+		"\n" +
+		"3,carol,9223372036854775807,\n" +
+		"4,dave,x,\n" +
+		"5,eve\n" +
+		"6,,-9223372036854775808,\"\"\"\"\n" +
+		"7,frank,9223372036854775808\n" +
+		"8,gina,1"
+
+	type result struct {
+		v   [2]int64
+		row int
+		err *parseint.CSVError
+	}
+	expect := []result{
+		{v: [2]int64{1, 42}, row: 2},
+		{v: [2]int64{2, -7}, row: 3},
+		{v: [2]int64{3, math.MaxInt64}, row: 4},
+		{row: 5, err: &parseint.CSVError{Row: 5, Column: 2, Err: parseint.ErrSyntax}},
+		{row: 6, err: &parseint.CSVError{
+			Row: 6, Column: 2, Err: parseint.ErrMissingColumn,
+		}},
+		{v: [2]int64{6, math.MinInt64}, row: 7},
+		{row: 8, err: &parseint.CSVError{Row: 8, Column: 2, Err: parseint.ErrOverflow}},
+		{v: [2]int64{8, 1}, row: 9},
+	}
+
+	check := func(s *parseint.CSVScanner[string]) {
+		require.NoError(t, s.Skip())
+		require.Equal(t, 1, s.Row())
+		for _, e := range expect {
+			var dst [2]int64
+			err := s.ScanInt64(dst[:])
+			require.Equal(t, e.row, s.Row())
+			if e.err != nil {
+				var ce *parseint.CSVError
+				require.True(t, errors.As(err, &ce), "row %d: %v", e.row, err)
+				require.Equal(t, *e.err, *ce)
+				require.ErrorIs(t, err, e.err.Err)
+				continue
+			}
+			require.NoError(t, err, "row %d", e.row)
+			require.Equal(t, e.v, dst, "row %d", e.row)
+		}
+		require.ErrorIs(t, s.ScanInt64(make([]int64, 2)), io.EOF)
+		require.ErrorIs(t, s.ScanInt64(make([]int64, 2)), io.EOF)
+	}
+	check(newCSVScanner(t, input, 0, 2))
+
+	// Columns in reverse order.
+	s := newCSVScanner(t, []byte(input), 2, 0)
+	require.NoError(t, s.Skip())
+	var dst [2]int64
+	require.NoError(t, s.ScanInt64(dst[:]))
+	require.Equal(t, [2]int64{42, 1}, dst)
+}
+
+func TestCSVScannerRepeatedColumn(t *testing.T) {
+	s := newCSVScanner(t, "1,2\n3,4\n", 0, 1, 0)
+	var dst [3]int64
+	require.NoError(t, s.ScanInt64(dst[:]))
+	require.Equal(t, [3]int64{1, 2, 1}, dst)
+	require.NoError(t, s.ScanInt64(dst[:]))
+	require.Equal(t, [3]int64{3, 4, 3}, dst)
+	require.ErrorIs(t, s.ScanInt64(dst[:]), io.EOF)
+
+	s = newCSVScanner(t, "1,2\n3\n", 1, 1)
+	require.NoError(t, s.ScanInt64(dst[:2]))
+	require.Equal(t, []int64{2, 2}, dst[:2])
+	err := s.ScanInt64(dst[:2])
+	require.ErrorIs(t, err, parseint.ErrMissingColumn)
+	require.Equal(t, "row 2, column 1: missing column", err.Error())
+}
+
+func TestCSVScannerInvalidColumn(t *testing.T) {
+	s, err := parseint.NewCSVScanner("1,2\n", 0, -1)
+	require.ErrorIs(t, err, parseint.ErrInvalidColumn)
+	require.Nil(t, s)
+}
+
+func TestCSVScannerLargeColumn(t *testing.T) {
+	s := newCSVScanner(t, "1,2\n", math.MaxInt32, 0)
+	dst := make([]int64, 2)
+	err := s.ScanInt64(dst)
+	require.ErrorIs(t, err, parseint.ErrMissingColumn)
+	require.Equal(t, "row 1, column 2147483647: missing column", err.Error())
+}
+
+func TestCSVScannerUint64(t *testing.T) {
+	s := newCSVScanner(t, "a;18446744073709551615;\"0\"\nb;-1;2\n", 1, 2)
+	s.Comma = ';'
+	var dst [2]uint64
+	require.NoError(t, s.ScanUint64(dst[:]))
+	require.Equal(t, [2]uint64{math.MaxUint64, 0}, dst)
+	err := s.ScanUint64(dst[:])
+	require.ErrorIs(t, err, parseint.ErrSyntax)
+	require.Equal(t, "row 2, column 1: syntax error", err.Error())
+	require.ErrorIs(t, s.ScanUint64(dst[:]), io.EOF)
+}
+
+func TestCSVScannerQuoteError(t *testing.T) {
+	for input, expect := range map[string]parseint.CSVError{
+		"1,\"2":         {Row: 1, Column: 1, Err: parseint.ErrSyntax},
+		"1,\"2\"x\n3,4": {Row: 1, Column: 1, Err: parseint.ErrSyntax},
+		"1,2\n\"3\" ,4": {Row: 2, Column: 0, Err: parseint.ErrSyntax},
+	} {
+		s := newCSVScanner(t, input, 0, 1)
+		var dst [2]int64
+		var err error
+		for err == nil {
+			err = s.ScanInt64(dst[:])
+		}
+		var ce *parseint.CSVError
+		require.True(t, errors.As(err, &ce), "%q", input)
+		require.Equal(t, expect, *ce, "%q", input)
+		require.Equal(t, err, s.ScanInt64(dst[:]), "the error must be sticky")
+	}
+}
+
+func TestCSVScannerAllocs(t *testing.T) {
+	input := strings.Repeat("1,x,\"-2\",\"a,b\",3\r\n", 100)
+	s := newCSVScanner(t, input, 0, 2, 4)
+	dst := make([]int64, 3)
+	allocs := testing.AllocsPerRun(10, func() {
+		*s = *newCSVScanner(t, input, 0, 2, 4)
+		for {
+			if err := s.ScanInt64(dst); err == io.EOF {
+				break
+			} else if err != nil {
+				panic(err)
+			}
+		}
+	})
+	// Only NewCSVScanner allocates.
+	require.LessOrEqual(t, allocs, float64(4))
+}
+
+func FuzzCSVScanner(f *testing.F) {
+	for _, s := range []string{
+		"1,2,3\n", "\"1\",\"2\"\r\n3,4", "1,\"a\"\"b\",3\n", "\n\n1,2,3", "1,2\r",
+		"1,\"x\ny\",2\n", "a,b,c\n1,2,3\n",
+	} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		r := csv.NewReader(strings.NewReader(s))
+		r.FieldsPerRecord = -1
+		records, err := r.ReadAll()
+		if err != nil {
+			return // Only compare inputs that are valid for encoding/csv.
+		}
+
+		cs := newCSVScanner(t, s, 0, 2)
+		dst := make([]int64, 2)
+		for i, rec := range records {
+			err := cs.ScanInt64(dst)
+			for j, col := range []int{0, 2} {
+				if col >= len(rec) {
+					if !errors.Is(err, parseint.ErrMissingColumn) {
+						t.Fatalf("%q: record %d: expected ErrMissingColumn; received: %v",
+							s, i, err)
+					}
+					break
+				}
+				v, errStd := strconv.ParseInt(rec[col], 10, 64)
+				if errStd != nil {
+					if err == nil {
+						t.Fatalf("%q: record %d: expected error for %q", s, i, rec[col])
+					}
+					break
+				}
+				if err == nil && dst[j] != v {
+					t.Fatalf("%q: record %d: expected %d; received: %d", s, i, v, dst[j])
+				}
+			}
+		}
+		if err := cs.ScanInt64(dst); err != io.EOF {
+			t.Fatalf("%q: expected io.EOF; received: %v", s, err)
+		}
+	})
+}
+
+func BenchmarkCSVScanner(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	var sb strings.Builder
+	for i := 0; i < 10_000; i++ {
+		sb.WriteString(strconv.Itoa(i))
+		sb.WriteString(",\"some text, quoted\",")
+		sb.WriteString(strconv.FormatInt(r.Int63(), 10))
+		sb.WriteString(",foo,bar\n")
+	}
+	input := sb.String()
+
+	fn := getBenchmarkImpl(b, func(input string) error {
+		cr := csv.NewReader(strings.NewReader(input))
+		cr.ReuseRecord = true
+		for {
+			rec, err := cr.Read()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if _, err := strconv.ParseInt(rec[2], 10, 64); err != nil {
+				return err
+			}
+		}
+	}, func(input string) error {
+		dst := make([]int64, 1)
+		s, err := parseint.NewCSVScanner(input, 2)
+		if err != nil {
+			return err
+		}
+		for {
+			err := s.ScanInt64(dst)
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+	})
+	for n := 0; n < b.N; n++ {
+		if err := fn(input); err != nil {
+			b.Fatal(err)
+		}
+	}
+	runtime.KeepAlive(input)
+}
+
+func newCSVScanner[S string | []byte](
+	t testing.TB, s S, columns ...int,
+) *parseint.CSVScanner[S] {
+	t.Helper()
+	c, err := parseint.NewCSVScanner(s, columns...)
+	require.NoError(t, err)
+	return c
+}
@@ -0,0 +1,104 @@
+package parseint
+
+// Digits2 parses s as exactly 2 ASCII base-10 digits, for example
+// the month "07" of an ISO 8601 date. Leading zeroes are allowed.
+// Returns ErrSyntax if s isn't exactly 2 digits long or contains
+// an invalid character. ErrOverflow is never returned.
+func Digits2[S string | []byte, U ~uint64 | ~uint32 | ~uint16](s S) (U, error) {
+	if len(s) != 2 {
+		return 0, ErrSyntax
+	}
+	d0, d1 := s[0]-'0', s[1]-'0'
+	if d0 > 9 || d1 > 9 { // Bytes below '0' wrap around.
+		return 0, ErrSyntax
+	}
+	return U(d0)*10 + U(d1), nil
+}
+
+// Digits3 parses s as exactly 3 ASCII base-10 digits, for example
+// the milliseconds "050" of a timestamp. Leading zeroes are allowed.
+// Returns ErrSyntax if s isn't exactly 3 digits long or contains
+// an invalid character. ErrOverflow is never returned.
+func Digits3[S string | []byte, U ~uint64 | ~uint32 | ~uint16](s S) (U, error) {
+	if len(s) != 3 {
+		return 0, ErrSyntax
+	}
+	d0, d1, d2 := s[0]-'0', s[1]-'0', s[2]-'0'
+	if d0 > 9 || d1 > 9 || d2 > 9 { // Bytes below '0' wrap around.
+		return 0, ErrSyntax
+	}
+	return U(d0)*100 + U(d1)*10 + U(d2), nil
+}
+
+// Digits4 parses s as exactly 4 ASCII base-10 digits, for example
+// the year "2024" of an ISO 8601 date. Leading zeroes are allowed.
+// Returns ErrSyntax if s isn't exactly 4 digits long or contains
+// an invalid character. ErrOverflow is never returned.
+func Digits4[S string | []byte, U ~uint64 | ~uint32 | ~uint16](s S) (U, error) {
+	if len(s) != 4 {
+		return 0, ErrSyntax
+	}
+	x := uint32(s[0]) | uint32(s[1])<<8 | uint32(s[2])<<16 | uint32(s[3])<<24
+	// Every byte must be within '0' (0x30) and '9' (0x39): its high nibble
+	// must be 3 both before and after adding 6, which moves ':' (0x3A)
+	// and above into the next high nibble.
+	if (x&0xF0F0F0F0)|((x+0x06060606)&0xF0F0F0F0)>>4 != 0x33333333 {
+		return 0, ErrSyntax
+	}
+	x -= 0x30303030
+	x = (x*10 + x>>8) & 0x00FF00FF // Combine adjacent digits into pairs.
+	x = (x*100 + x>>16) & 0x0000FFFF
+	return U(x), nil
+}
+
+// Digits6 parses s as exactly 6 ASCII base-10 digits, for example
+// the time "225000" of a "20241016T225000" timestamp.
+// Leading zeroes are allowed.
+// Returns ErrSyntax if s isn't exactly 6 digits long or contains
+// an invalid character. ErrOverflow is never returned.
+func Digits6[S string | []byte, U ~uint64 | ~uint32](s S) (U, error) {
+	if len(s) != 6 {
+		return 0, ErrSyntax
+	}
+	// Prepend two '0' digits to process 8 digits at once.
+	x := 0x3030 | uint64(s[0])<<16 | uint64(s[1])<<24 | uint64(s[2])<<32 |
+		uint64(s[3])<<40 | uint64(s[4])<<48 | uint64(s[5])<<56
+	v, ok := digits8(x)
+	if !ok {
+		return 0, ErrSyntax
+	}
+	return U(v), nil
+}
+
+// Digits8 parses s as exactly 8 ASCII base-10 digits, for example
+// the date "20241016" of a "20241016T225000" timestamp.
+// Leading zeroes are allowed.
+// Returns ErrSyntax if s isn't exactly 8 digits long or contains
+// an invalid character. ErrOverflow is never returned.
+func Digits8[S string | []byte, U ~uint64 | ~uint32](s S) (U, error) {
+	if len(s) != 8 {
+		return 0, ErrSyntax
+	}
+	x := uint64(s[0]) | uint64(s[1])<<8 | uint64(s[2])<<16 | uint64(s[3])<<24 |
+		uint64(s[4])<<32 | uint64(s[5])<<40 | uint64(s[6])<<48 | uint64(s[7])<<56
+	v, ok := digits8(x)
+	if !ok {
+		return 0, ErrSyntax
+	}
+	return U(v), nil
+}
+
+// digits8 converts the 8 ASCII digits in x, the first digit in the lowest byte,
+// using SIMD within a register. Returns ok=false if any byte isn't a digit.
+func digits8(x uint64) (v uint32, ok bool) {
+	// See Digits4 for the validation.
+	const hi = 0xF0F0F0F0F0F0F0F0
+	if (x&hi)|((x+0x0606060606060606)&hi)>>4 != 0x3333333333333333 {
+		return 0, false
+	}
+	x -= 0x3030303030303030
+	x = (x*10 + x>>8) & 0x00FF00FF00FF00FF // Combine adjacent digits into pairs.
+	x = (x*100 + x>>16) & 0x0000FFFF0000FFFF
+	x = (x*10000 + x>>32) & 0xFFFFFFFF
+	return uint32(x), true
+}
@@ -0,0 +1,159 @@
+package parseint_test
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+var digitsFuncs = []struct {
+	width  int
+	string func(string) (uint32, error)
+	bytes  func([]byte) (uint32, error)
+}{
+	{2, parseint.Digits2[string, uint32], parseint.Digits2[[]byte, uint32]},
+	{3, parseint.Digits3[string, uint32], parseint.Digits3[[]byte, uint32]},
+	{4, parseint.Digits4[string, uint32], parseint.Digits4[[]byte, uint32]},
+	{6, parseint.Digits6[string, uint32], parseint.Digits6[[]byte, uint32]},
+	{8, parseint.Digits8[string, uint32], parseint.Digits8[[]byte, uint32]},
+}
+
+func TestDigits(t *testing.T) {
+	for _, f := range digitsFuncs {
+		t.Run(strconv.Itoa(f.width), func(t *testing.T) {
+			call := func(input string, fn func(uint32, error)) {
+				fn(f.string(input))
+				fn(f.bytes([]byte(input)))
+			}
+
+			max := uint32(1)
+			for i := 0; i < f.width; i++ {
+				max *= 10
+			}
+			step := max / 100_000 // Check every value up to 4 digits.
+			if step == 0 {
+				step = 1
+			}
+			for v := uint32(0); v < max; v += step {
+				input := fmt.Sprintf("%0*d", f.width, v)
+				call(input, func(actual uint32, err error) {
+					require.NoError(t, err, "%q", input)
+					require.Equal(t, v, actual, "%q", input)
+				})
+			}
+			input := fmt.Sprintf("%0*d", f.width, max-1)
+			call(input, func(actual uint32, err error) {
+				require.NoError(t, err, "%q", input)
+				require.Equal(t, max-1, actual, "%q", input)
+			})
+
+			// Wrong length.
+			for _, input := range []string{
+				"",
+				fmt.Sprintf("%0*d", f.width-1, 0),
+				fmt.Sprintf("%0*d", f.width+1, 0),
+			} {
+				call(input, func(a uint32, err error) {
+					require.ErrorIs(t, err, parseint.ErrSyntax, "%q", input)
+					require.Zero(t, a)
+				})
+			}
+
+			// Any non-digit byte at any position.
+			for i := 0; i < f.width; i++ {
+				for c := 0; c < 256; c++ {
+					if c >= '0' && c <= '9' {
+						continue
+					}
+					b := []byte(fmt.Sprintf("%0*d", f.width, max-1))
+					b[i] = byte(c)
+					input := string(b)
+					call(input, func(a uint32, err error) {
+						require.ErrorIs(t, err, parseint.ErrSyntax, "%q", input)
+						require.Zero(t, a)
+					})
+				}
+			}
+		})
+	}
+
+	t.Run("timestamp", func(t *testing.T) {
+		const s = "20241016T225000"
+		date, err := parseint.Digits8[string, uint32](s[:8])
+		require.NoError(t, err)
+		require.Equal(t, uint32(20241016), date)
+		tm, err := parseint.Digits6[string, uint64](s[9:])
+		require.NoError(t, err)
+		require.Equal(t, uint64(225000), tm)
+		year, err := parseint.Digits4[string, uint16](s[:4])
+		require.NoError(t, err)
+		require.Equal(t, uint16(2024), year)
+		month, err := parseint.Digits2[string, uint16](s[4:6])
+		require.NoError(t, err)
+		require.Equal(t, uint16(10), month)
+	})
+}
+
+func FuzzDigits(f *testing.F) {
+	for _, s := range []string{
+		"00", "99", "000", "999", "0000", "9999", "2024", "225000",
+		"20241016", "99999999", "1234567:", "/0000000", "12 4", "",
+	} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		for _, fn := range digitsFuncs {
+			x, err := fn.string(s)
+			isDigits := len(s) == fn.width
+			for _, c := range []byte(s) {
+				isDigits = isDigits && c >= '0' && c <= '9'
+			}
+			if !isDigits {
+				if err != parseint.ErrSyntax || x != 0 {
+					t.Fatalf("Digits%d(%q): expected ErrSyntax; received: %d, %v",
+						fn.width, s, x, err)
+				}
+				continue
+			}
+			std, _ := strconv.ParseUint(s, 10, 32)
+			if err != nil || uint64(x) != std {
+				t.Fatalf("Digits%d(%q): expected %d; received: %d, %v",
+					fn.width, s, std, x, err)
+			}
+		}
+	})
+}
+
+func BenchmarkDigits8(b *testing.B) {
+	fn := getBenchmarkFn(b, func(s string) (uint32, error) {
+		if len(s) != 8 {
+			return 0, strconv.ErrSyntax
+		}
+		v, err := strconv.ParseUint(s, 10, 32)
+		return uint32(v), err
+	}, parseint.Digits8[string, uint32])
+
+	var a uint32
+	var err error
+	for _, td := range []struct {
+		name  string
+		input string
+	}{
+		{"date", "20241016"},
+		{"max", "99999999"},
+		{"syntax", "2024-10-"},
+	} {
+		b.Run(td.name+"/string", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				a, err = fn(td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
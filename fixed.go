@@ -0,0 +1,123 @@
+package parseint
+
+// Rounding defines how Base10Fixed64 handles fractional digits
+// beyond the requested scale.
+type Rounding uint8
+
+const (
+	// RoundReject rejects non-zero fractional digits beyond the scale
+	// with ErrSyntax. Excess trailing zeroes are accepted.
+	RoundReject Rounding = iota
+
+	// RoundTruncate discards fractional digits beyond the scale,
+	// rounding toward zero.
+	RoundTruncate
+
+	// RoundHalfEven rounds to the nearest value and ties to the even value
+	// (banker's rounding), for example "0.125" at scale 2 becomes 12.
+	RoundHalfEven
+
+	// RoundHalfUp rounds to the nearest value and ties away from zero,
+	// for example "0.125" at scale 2 becomes 13 and "-0.125" becomes -13.
+	RoundHalfUp
+)
+
+// Base10Fixed64 parses s as a base-10 fixed-point decimal number
+// with an optional '-' or '+' sign and an optional fraction, for example
+// "1234.56" or "-0.005", and returns it as an int64 scaled by 10^scale.
+// For example "1234.56" at scale 2 is 123456 and "-0.005" at scale 6 is -5000.
+// At least one digit is required before and after the decimal point '.'.
+// Fractional digits beyond the scale are handled according to rounding.
+// Returns ErrSyntax if s contains an invalid character, if scale is negative
+// or if rounding is RoundReject and s has too many fractional digits.
+// Returns ErrOverflow if the scaled value overflows an int64.
+func Base10Fixed64[S string | []byte](s S, scale int, rounding Rounding) (int64, error) {
+	if len(s) == 0 || scale < 0 {
+		return 0, ErrSyntax
+	}
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	max := uint64(1<<63 - 1)
+	if neg {
+		max = 1 << 63
+	}
+
+	var n uint64
+	i := 0
+	for ; i < len(s) && s[i] != '.'; i++ { // Integer part.
+		c := s[i]
+		if c < '0' || c > '9' {
+			return 0, ErrSyntax
+		}
+		d := uint64(c - '0')
+		if n > (max-d)/10 {
+			return 0, ErrOverflow
+		}
+		n = n*10 + d
+	}
+	if i == 0 { // No integer digits.
+		return 0, ErrSyntax
+	}
+	var frac S
+	if i < len(s) {
+		frac = s[i+1:]
+		if len(frac) == 0 { // No fractional digits after the decimal point.
+			return 0, ErrSyntax
+		}
+	}
+	for _, c := range []byte(frac) {
+		if c < '0' || c > '9' {
+			return 0, ErrSyntax
+		}
+	}
+
+	for i := 0; i < scale; i++ { // Scaled fractional part.
+		var d uint64
+		if i < len(frac) {
+			d = uint64(frac[i] - '0')
+		}
+		if n > (max-d)/10 {
+			return 0, ErrOverflow
+		}
+		n = n*10 + d
+	}
+
+	if len(frac) > scale { // Excess fractional digits.
+		first := frac[scale] - '0'
+		sticky := false // Any non-zero digit after the first excess digit.
+		for _, c := range []byte(frac[scale+1:]) {
+			if c != '0' {
+				sticky = true
+				break
+			}
+		}
+		roundUp := false
+		switch rounding {
+		case RoundReject:
+			if first != 0 || sticky {
+				return 0, ErrSyntax
+			}
+		case RoundHalfEven:
+			roundUp = first > 5 || (first == 5 && (sticky || n&1 == 1))
+		case RoundHalfUp:
+			roundUp = first >= 5
+		}
+		if roundUp {
+			if n == max {
+				return 0, ErrOverflow
+			}
+			n++
+		}
+	}
+
+	if neg {
+		return int64(-n), nil
+	}
+	return int64(n), nil
+}
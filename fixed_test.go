@@ -0,0 +1,220 @@
+package parseint_test
+
+import (
+	"math"
+	"math/big"
+	"regexp"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedInput struct {
+	Input    string
+	Scale    int
+	Rounding parseint.Rounding
+}
+
+var validBase10Fixed64 = map[fixedInput]int64{
+	{"0", 2, parseint.RoundReject}:                      0,
+	{"-0", 2, parseint.RoundReject}:                     0,
+	{"1234.56", 2, parseint.RoundReject}:                123456,
+	{"1234.5", 2, parseint.RoundReject}:                 123450,
+	{"1234", 2, parseint.RoundReject}:                   123400,
+	{"+1234", 2, parseint.RoundReject}:                  123400,
+	{"-1234.56", 2, parseint.RoundReject}:               -123456,
+	{"1234.5600", 2, parseint.RoundReject}:              123456,
+	{"-0.005", 6, parseint.RoundReject}:                 -5000,
+	{"-0.005", 3, parseint.RoundReject}:                 -5,
+	{"42", 0, parseint.RoundReject}:                     42,
+	{"42.000", 0, parseint.RoundReject}:                 42,
+	{"0.000000000000000001", 18, parseint.RoundReject}:  1,
+	{"9.223372036854775807", 18, parseint.RoundReject}:  math.MaxInt64,
+	{"-9.223372036854775808", 18, parseint.RoundReject}: math.MinInt64,
+	{"92233720368547758.07", 2, parseint.RoundReject}:   math.MaxInt64,
+	{"-92233720368547758.08", 2, parseint.RoundReject}:  math.MinInt64,
+
+	{"1.239", 2, parseint.RoundTruncate}:  123,
+	{"-1.239", 2, parseint.RoundTruncate}: -123,
+	{"-0.005", 2, parseint.RoundTruncate}: 0,
+	{"0.999", 0, parseint.RoundTruncate}:  0,
+
+	{"0.125", 2, parseint.RoundHalfEven}:   12,
+	{"0.135", 2, parseint.RoundHalfEven}:   14,
+	{"0.1251", 2, parseint.RoundHalfEven}:  13,
+	{"0.12500", 2, parseint.RoundHalfEven}: 12,
+	{"0.124", 2, parseint.RoundHalfEven}:   12,
+	{"0.126", 2, parseint.RoundHalfEven}:   13,
+	{"-0.125", 2, parseint.RoundHalfEven}:  -12,
+	{"-0.135", 2, parseint.RoundHalfEven}:  -14,
+	{"2.5", 0, parseint.RoundHalfEven}:     2,
+	{"3.5", 0, parseint.RoundHalfEven}:     4,
+
+	{"0.125", 2, parseint.RoundHalfUp}:                    13,
+	{"0.124", 2, parseint.RoundHalfUp}:                    12,
+	{"-0.125", 2, parseint.RoundHalfUp}:                   -13,
+	{"2.5", 0, parseint.RoundHalfUp}:                      3,
+	{"-2.5", 0, parseint.RoundHalfUp}:                     -3,
+	{"-0.004", 2, parseint.RoundHalfUp}:                   0,
+	{"92233720368547758.074", 2, parseint.RoundHalfUp}:    math.MaxInt64,
+	{"-92233720368547758.075", 2, parseint.RoundHalfEven}: math.MinInt64,
+}
+
+var invalidBase10Fixed64 = map[fixedInput]error{
+	{"", 2, parseint.RoundReject}:        parseint.ErrSyntax,
+	{"-", 2, parseint.RoundReject}:       parseint.ErrSyntax,
+	{".", 2, parseint.RoundReject}:       parseint.ErrSyntax,
+	{".5", 2, parseint.RoundReject}:      parseint.ErrSyntax,
+	{"5.", 2, parseint.RoundReject}:      parseint.ErrSyntax,
+	{"-.5", 2, parseint.RoundReject}:     parseint.ErrSyntax,
+	{"1.2.3", 2, parseint.RoundReject}:   parseint.ErrSyntax,
+	{"1,5", 2, parseint.RoundReject}:     parseint.ErrSyntax,
+	{"1.5x", 2, parseint.RoundTruncate}:  parseint.ErrSyntax,
+	{"1.50x", 1, parseint.RoundTruncate}: parseint.ErrSyntax,
+	{"1e3", 2, parseint.RoundReject}:     parseint.ErrSyntax,
+	{" 1.5", 2, parseint.RoundReject}:    parseint.ErrSyntax,
+	{"1", -1, parseint.RoundReject}:      parseint.ErrSyntax,
+	{"1.234", 2, parseint.RoundReject}:   parseint.ErrSyntax,
+	{"1.2301", 2, parseint.RoundReject}:  parseint.ErrSyntax,
+	{"-0.005", 2, parseint.RoundReject}:  parseint.ErrSyntax,
+
+	{"92233720368547758.08", 2, parseint.RoundReject}:    parseint.ErrOverflow,
+	{"-92233720368547758.09", 2, parseint.RoundReject}:   parseint.ErrOverflow,
+	{"92233720368547758.075", 2, parseint.RoundHalfUp}:   parseint.ErrOverflow,
+	{"92233720368547758.075", 2, parseint.RoundHalfEven}: parseint.ErrOverflow,
+	{"10", 18, parseint.RoundReject}:                     parseint.ErrOverflow,
+	{"1", 19, parseint.RoundReject}:                      parseint.ErrOverflow,
+	{"99999999999999999999", 0, parseint.RoundReject}:    parseint.ErrOverflow,
+}
+
+func TestBase10Fixed64(t *testing.T) {
+	callBase10Fixed64 := func(in fixedInput, fn func(int64, error)) {
+		fn(parseint.Base10Fixed64(in.Input, in.Scale, in.Rounding))
+		fn(parseint.Base10Fixed64([]byte(in.Input), in.Scale, in.Rounding))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range validBase10Fixed64 {
+			callBase10Fixed64(input, func(actual int64, err error) {
+				require.NoError(t, err, "%#v", input)
+				require.Equal(t, expect, actual, "%#v", input)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range invalidBase10Fixed64 {
+			callBase10Fixed64(input, func(a int64, err error) {
+				require.ErrorIs(t, err, expectedErr, "%#v", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+
+	t.Run("zero_scale", func(t *testing.T) {
+		for i := int64(-10_000); i <= 10_000; i++ {
+			in := fixedInput{strconv.FormatInt(i, 10), 0, parseint.RoundReject}
+			callBase10Fixed64(in, func(actual int64, err error) {
+				require.NoError(t, err, "%#v", in)
+				require.Equal(t, i, actual, "%#v", in)
+			})
+		}
+	})
+}
+
+var fixedSyntax = regexp.MustCompile(`^[-+]?[0-9]+(\.[0-9]+)?$`)
+
+func FuzzBase10Fixed64(f *testing.F) {
+	for input := range validBase10Fixed64 {
+		f.Add(input.Input, uint8(input.Scale), uint8(input.Rounding))
+	}
+	for input := range invalidBase10Fixed64 {
+		f.Add(input.Input, uint8(input.Scale), uint8(input.Rounding))
+	}
+
+	f.Fuzz(func(t *testing.T, s string, scale, rounding uint8) {
+		scale, rounding = scale%24, rounding%4
+		x, err := parseint.Base10Fixed64(s, int(scale), parseint.Rounding(rounding))
+
+		// Compute the reference result using big.Rat.
+		r, ok := new(big.Rat).SetString(s)
+		if ok && !fixedSyntax.MatchString(s) {
+			ok = false
+		}
+		if !ok {
+			// Like Base10Int64, overflow may be reported before a syntax error.
+			if err != parseint.ErrSyntax && err != parseint.ErrOverflow {
+				t.Fatalf("%q: expected ErrSyntax; received: %d, %v", s, x, err)
+			}
+			return
+		}
+		r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)))
+		q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+		// q is truncated toward zero; m has the sign of the numerator.
+		twice := new(big.Int).Mul(new(big.Int).Abs(m), big.NewInt(2))
+		cmpHalf := twice.Cmp(r.Denom())
+		up := false
+		switch parseint.Rounding(rounding) {
+		case parseint.RoundReject:
+			if m.Sign() != 0 {
+				if err != parseint.ErrSyntax && err != parseint.ErrOverflow {
+					t.Fatalf("%q: expected ErrSyntax; received: %d, %v", s, x, err)
+				}
+				return
+			}
+		case parseint.RoundHalfEven:
+			up = cmpHalf > 0 || (cmpHalf == 0 && q.Bit(0) == 1)
+		case parseint.RoundHalfUp:
+			up = cmpHalf >= 0
+		}
+		if up {
+			if r.Sign() < 0 {
+				q.Sub(q, big.NewInt(1))
+			} else {
+				q.Add(q, big.NewInt(1))
+			}
+		}
+		if !q.IsInt64() {
+			if err != parseint.ErrOverflow {
+				t.Fatalf("%q: expected ErrOverflow; received: %d, %v", s, x, err)
+			}
+			return
+		}
+		if err != nil || x != q.Int64() {
+			t.Fatalf("%q (scale %d, rounding %d): expected %s; received: %d, %v",
+				s, scale, rounding, q, x, err)
+		}
+	})
+}
+
+func BenchmarkBase10Fixed64(b *testing.B) {
+	fn := getBenchmarkFn(b, func(s string) (int64, error) {
+		f, err := strconv.ParseFloat(s, 64)
+		return int64(f * 100), err
+	}, func(s string) (int64, error) {
+		return parseint.Base10Fixed64(s, 2, parseint.RoundHalfEven)
+	})
+
+	var a int64
+	var err error
+	for _, td := range []struct {
+		name  string
+		input string
+	}{
+		{"int", "1234"},
+		{"cents", "1234.56"},
+		{"round", "-1234.5651"},
+		{"syntax", "12.34.56"},
+	} {
+		b.Run(td.name+"/string", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				a, err = fn(td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
@@ -0,0 +1,189 @@
+package parseint
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"slices"
+)
+
+// AppendBase10Int64 appends the base-10 representation of i to dst
+// and returns the extended buffer.
+// AppendBase10Int64 is comparable to strconv.AppendInt(dst, i, 10)
+// but is more efficient.
+func AppendBase10Int64[I ~int64 | ~int](dst []byte, i I) []byte {
+	if 0 <= i && i < 100 {
+		return appendBase10Small(dst, uint8(i))
+	}
+	u := uint64(i)
+	if i < 0 {
+		u = -u
+	}
+	if u <= 1<<32-1 {
+		return appendBase10Uint32(dst, uint32(u), i < 0)
+	}
+	return appendBase10Uint64(dst, u, i < 0)
+}
+
+// AppendBase10Uint64 appends the base-10 representation of u to dst
+// and returns the extended buffer.
+// AppendBase10Uint64 is comparable to strconv.AppendUint(dst, u, 10)
+// but is more efficient.
+func AppendBase10Uint64[U ~uint64 | ~uint | ~uintptr](dst []byte, u U) []byte {
+	if u < 100 {
+		return appendBase10Small(dst, uint8(u))
+	}
+	if u <= 1<<32-1 {
+		return appendBase10Uint32(dst, uint32(u), false)
+	}
+	return appendBase10Uint64(dst, uint64(u), false)
+}
+
+// AppendBase10Int32 appends the base-10 representation of i to dst
+// and returns the extended buffer.
+// AppendBase10Int32 is comparable to strconv.AppendInt(dst, int64(i), 10)
+// but is more efficient.
+func AppendBase10Int32[I ~int32 | ~int16 | ~int8](dst []byte, i I) []byte {
+	if 0 <= i && i < 100 {
+		return appendBase10Small(dst, uint8(i))
+	}
+	u := uint32(i)
+	if i < 0 {
+		u = -u
+	}
+	return appendBase10Uint32(dst, u, i < 0)
+}
+
+// AppendBase10Uint32 appends the base-10 representation of u to dst
+// and returns the extended buffer.
+// AppendBase10Uint32 is comparable to strconv.AppendUint(dst, uint64(u), 10)
+// but is more efficient.
+func AppendBase10Uint32[U ~uint32 | ~uint16 | ~uint8](dst []byte, u U) []byte {
+	if u < 100 {
+		return appendBase10Small(dst, uint8(u))
+	}
+	return appendBase10Uint32(dst, uint32(u), false)
+}
+
+// digitPairs holds the two digits of every number from 00 to 99.
+const digitPairs = "0001020304050607080910111213141516171819" +
+	"2021222324252627282930313233343536373839" +
+	"4041424344454647484950515253545556575859" +
+	"6061626364656667686970717273747576777879" +
+	"8081828384858687888990919293949596979899"
+
+// pow10 holds the powers of 10 that fit a uint64.
+var pow10 = [20]uint64{
+	1, 10, 100, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10,
+	1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+}
+
+// digits10 returns the number of base-10 digits of u.
+func digits10(u uint64) int {
+	// 1233/4096 approximates log10(2) such that t is either
+	// the number of digits or one less.
+	// u|1 has the same number of digits as u but is never 0.
+	u |= 1
+	t := bits.Len64(u) * 1233 >> 12
+	if u < pow10[t] {
+		return t
+	}
+	return t + 1
+}
+
+// appendBase10Small appends u < 100.
+func appendBase10Small(dst []byte, u uint8) []byte {
+	if u < 10 {
+		return append(dst, u+'0')
+	}
+	return append(dst, digitPairs[u*2], digitPairs[u*2+1])
+}
+
+// appendBase10Uint64 appends u > math.MaxUint32
+// with a preceding '-' if neg is true.
+func appendBase10Uint64(dst []byte, u uint64, neg bool) []byte {
+	n := digits10(u)
+	if neg {
+		n++
+	}
+	l := len(dst)
+	if cap(dst)-l < n {
+		dst = slices.Grow(dst, n)
+	}
+	dst = dst[:l+n]
+	b := dst[l:]
+	// Split u into the leading digits and up to two blocks of 8 digits
+	// such that the leading digits fit 32 bits.
+	i := len(b) - 8
+	hi := u / 1e8
+	writeDigits8(b[i:], uint32(u-hi*1e8))
+	if hi >= 1e8 {
+		q := hi / 1e8
+		i -= 8
+		writeDigits8(b[i:], uint32(hi-q*1e8))
+		hi = q
+	}
+	i = writeDigits(b[:i], uint32(hi))
+	if neg {
+		b[i-1] = '-'
+	}
+	return dst
+}
+
+// appendBase10Uint32 is similar to appendBase10Uint64 but for 32-bit values.
+func appendBase10Uint32(dst []byte, u uint32, neg bool) []byte {
+	n := digits10(uint64(u))
+	if neg {
+		n++
+	}
+	l := len(dst)
+	if cap(dst)-l < n {
+		dst = slices.Grow(dst, n)
+	}
+	dst = dst[:l+n]
+	b := dst[l:]
+	i := writeDigits(b, u)
+	if neg {
+		b[i-1] = '-'
+	}
+	return dst
+}
+
+// writeDigits writes the digits of u to the end of b
+// and returns the index of the first written digit.
+func writeDigits(b []byte, u uint32) int {
+	i := len(b)
+	if u >= 1e8 {
+		q := u / 1e8
+		i -= 8
+		writeDigits8(b[i:], u-q*1e8)
+		u = q
+	}
+	for u >= 100 { // Write 2 digits at a time.
+		q := u / 100
+		r := (u - q*100) * 2
+		i -= 2
+		b[i], b[i+1] = digitPairs[r], digitPairs[r+1]
+		u = q
+	}
+	if u >= 10 {
+		i -= 2
+		b[i], b[i+1] = digitPairs[u*2], digitPairs[u*2+1]
+	} else {
+		i--
+		b[i] = byte(u) + '0'
+	}
+	return i
+}
+
+// writeDigits8 writes u < 1e8 as exactly 8 zero-padded digits to b.
+func writeDigits8(b []byte, u uint32) {
+	// Split u into 8 single-digit byte lanes of a uint64
+	// with the most significant digit in the least significant byte.
+	hi, lo := u/10_000, u%10_000
+	v := uint64(hi) | uint64(lo)<<32             // 2 lanes of 4 digits.
+	q := (v * 10486 >> 20) & 0x0000007f_0000007f // x/100 for x < 10000.
+	v = q | (v-q*100)<<16                        // 4 lanes of 2 digits.
+	q = (v * 103 >> 10) & 0x000f000f_000f000f    // x/10 for x < 100.
+	v = q | (v-q*10)<<8                          // 8 lanes of 1 digit.
+	binary.LittleEndian.PutUint64(b, v|0x30303030_30303030)
+}
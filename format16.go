@@ -0,0 +1,74 @@
+package parseint
+
+import (
+	"math/bits"
+	"slices"
+)
+
+// HexOptions configures the AppendBase16 functions.
+type HexOptions struct {
+	// Width is the minimum number of digits. Shorter values are padded
+	// with leading zeroes, for example 0xab with width 4 is "00ab".
+	// Longer values are never truncated.
+	Width int
+
+	// Upper makes the digits 'a'-'f' uppercase.
+	// The "0x" prefix is always lowercase.
+	Upper bool
+
+	// Prefix prepends "0x".
+	Prefix bool
+}
+
+// AppendBase16Uint16 appends the base-16 (hexadecimal) representation of u
+// to dst formatted according to opts and returns the extended buffer.
+// The result can be parsed with Base16Uint16 unless opts.Prefix is set.
+func AppendBase16Uint16[U ~uint16 | ~uint8](dst []byte, u U, opts HexOptions) []byte {
+	return appendBase16(dst, uint64(u), opts)
+}
+
+// AppendBase16Uint32 appends the base-16 (hexadecimal) representation of u
+// to dst formatted according to opts and returns the extended buffer.
+// The result can be parsed with Base16Uint32 unless opts.Prefix is set.
+func AppendBase16Uint32[U ~uint32 | ~uint16 | ~uint8](
+	dst []byte, u U, opts HexOptions,
+) []byte {
+	return appendBase16(dst, uint64(u), opts)
+}
+
+// AppendBase16Uint64 appends the base-16 (hexadecimal) representation of u
+// to dst formatted according to opts and returns the extended buffer.
+// AppendBase16Uint64 is comparable to fmt.Appendf(dst, "%016x", u) with
+// opts.Width 16 but doesn't allocate.
+func AppendBase16Uint64[U ~uint64 | ~uint | ~uintptr](
+	dst []byte, u U, opts HexOptions,
+) []byte {
+	return appendBase16(dst, uint64(u), opts)
+}
+
+const (
+	hexDigitsLower = "0123456789abcdef"
+	hexDigitsUpper = "0123456789ABCDEF"
+)
+
+func appendBase16(dst []byte, u uint64, opts HexOptions) []byte {
+	n := max((bits.Len64(u)+3)/4, opts.Width, 1)
+	if opts.Prefix {
+		dst = append(dst, '0', 'x')
+	}
+	l := len(dst)
+	if cap(dst)-l < n {
+		dst = slices.Grow(dst, n)
+	}
+	dst = dst[:l+n]
+	b := dst[l:]
+	digits := hexDigitsLower
+	if opts.Upper {
+		digits = hexDigitsUpper
+	}
+	for i := len(b) - 1; i >= 0; i-- { // Zero-pads once u is exhausted.
+		b[i] = digits[u&0xf]
+		u >>= 4
+	}
+	return dst
+}
@@ -0,0 +1,201 @@
+package parseint_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+// hexFmt returns the fmt reference for opts.
+func hexFmt(u uint64, opts parseint.HexOptions) string {
+	verb := "x"
+	if opts.Upper {
+		verb = "X"
+	}
+	s := fmt.Sprintf("%0*"+verb, opts.Width, u)
+	if opts.Prefix {
+		s = "0x" + s
+	}
+	return s
+}
+
+var hexOptions = []parseint.HexOptions{
+	{},
+	{Width: 2},
+	{Width: 4},
+	{Width: 8},
+	{Width: 16},
+	{Width: 20},
+	{Upper: true},
+	{Prefix: true},
+	{Width: 8, Upper: true, Prefix: true},
+}
+
+func TestAppendBase16(t *testing.T) {
+	for input, expect := range map[struct {
+		U    uint64
+		Opts parseint.HexOptions
+	}]string{
+		{0, parseint.HexOptions{}}:                                   "0",
+		{0, parseint.HexOptions{Width: 4}}:                           "0000",
+		{0, parseint.HexOptions{Prefix: true}}:                       "0x0",
+		{0xab, parseint.HexOptions{Width: 4}}:                        "00ab",
+		{0xab, parseint.HexOptions{Width: 1}}:                        "ab",
+		{0xab, parseint.HexOptions{Upper: true}}:                     "AB",
+		{0xdeadbeef, parseint.HexOptions{Width: 8}}:                  "deadbeef",
+		{0xdeadbeef, parseint.HexOptions{Width: 16}}:                 "00000000deadbeef",
+		{0xdeadbeef, parseint.HexOptions{Width: 4}}:                  "deadbeef",
+		{0xdeadbeef, parseint.HexOptions{Prefix: true, Upper: true}}: "0xDEADBEEF",
+		{math.MaxUint64, parseint.HexOptions{}}:                      "ffffffffffffffff",
+		{math.MaxUint64, parseint.HexOptions{Width: 18}}:             "00ffffffffffffffff",
+	} {
+		require.Equal(t, expect,
+			string(parseint.AppendBase16Uint64(nil, input.U, input.Opts)), "%#v", input)
+		require.Equal(t, "x"+expect,
+			string(parseint.AppendBase16Uint64([]byte("x"), input.U, input.Opts)),
+			"%#v", input)
+	}
+}
+
+func TestAppendBase16Uint16(t *testing.T) {
+	for u := 0; u <= math.MaxUint16; u++ {
+		for _, opts := range hexOptions {
+			b := parseint.AppendBase16Uint16(nil, uint16(u), opts)
+			require.Equal(t, hexFmt(uint64(u), opts), string(b))
+			if opts.Prefix {
+				continue
+			}
+			v, err := parseint.Base16Uint16[[]byte, uint16](b)
+			require.NoError(t, err, "%q", b)
+			require.Equal(t, uint16(u), v)
+		}
+	}
+	require.Equal(t, "ff", string(parseint.AppendBase16Uint16(nil, uint8(0xff),
+		parseint.HexOptions{})))
+}
+
+func TestAppendBase16Uint32(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100_000; n++ {
+		u := r.Uint32() >> r.Intn(32)
+		for _, opts := range hexOptions {
+			b := parseint.AppendBase16Uint32(nil, u, opts)
+			require.Equal(t, hexFmt(uint64(u), opts), string(b))
+			if opts.Prefix {
+				continue
+			}
+			v, err := parseint.Base16Uint32[[]byte, uint32](b)
+			require.NoError(t, err, "%q", b)
+			require.Equal(t, u, v)
+		}
+	}
+	b := parseint.AppendBase16Uint32(nil, uint32(math.MaxUint32), parseint.HexOptions{})
+	require.Equal(t, "ffffffff", string(b))
+}
+
+func TestAppendBase16Uint64(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100_000; n++ {
+		u := r.Uint64() >> r.Intn(64)
+		for _, opts := range hexOptions {
+			b := parseint.AppendBase16Uint64(nil, u, opts)
+			require.Equal(t, hexFmt(u, opts), string(b))
+			if opts.Prefix {
+				continue
+			}
+			v, err := strconv.ParseUint(string(b), 16, 64)
+			require.NoError(t, err, "%q", b)
+			require.Equal(t, u, v)
+		}
+	}
+}
+
+func TestAppendBase16Allocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	opts := parseint.HexOptions{Width: 16, Prefix: true}
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = parseint.AppendBase16Uint64(buf[:0], uint64(0xdeadbeef), opts)
+		buf = parseint.AppendBase16Uint32(buf, uint32(0xbeef), opts)
+		buf = parseint.AppendBase16Uint16(buf, uint16(0xef), opts)
+	})
+	require.Zero(t, allocs)
+}
+
+func FuzzAppendBase16Uint64(f *testing.F) {
+	for _, u := range []uint64{0, 1, 0xf, 0x10, 0xdeadbeef, math.MaxUint64} {
+		f.Add(u, uint8(0), false, false)
+		f.Add(u, uint8(16), true, true)
+	}
+
+	f.Fuzz(func(t *testing.T, u uint64, width uint8, upper, prefix bool) {
+		opts := parseint.HexOptions{Width: int(width % 32), Upper: upper, Prefix: prefix}
+		b := parseint.AppendBase16Uint64(nil, u, opts)
+		if s := hexFmt(u, opts); string(b) != s {
+			t.Fatalf("%#v: expected %q; received: %q", opts, s, b)
+		}
+		if v, err := parseint.Base16Uint32[[]byte, uint32](
+			parseint.AppendBase16Uint32(nil, uint32(u), parseint.HexOptions{
+				Width: opts.Width, Upper: upper,
+			}),
+		); err != nil || v != uint32(u) {
+			t.Fatalf("round-trip uint32: expected %x; received: %x, %v", uint32(u), v, err)
+		}
+	})
+}
+
+func BenchmarkAppendBase16Uint64(b *testing.B) {
+	opts := parseint.HexOptions{Width: 16}
+	fn := getAppendBenchmarkFn(b, func(dst []byte, u uint64) []byte {
+		return fmt.Appendf(dst, "%016x", u)
+	}, func(dst []byte, u uint64) []byte {
+		return parseint.AppendBase16Uint64(dst, u, opts)
+	})
+
+	buf := make([]byte, 0, 32)
+	for _, td := range []struct {
+		name  string
+		input uint64
+	}{
+		{"0", 0},
+		{"u32", 0xdeadbeef},
+		{"max", math.MaxUint64},
+	} {
+		b.Run(td.name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				buf = fn(buf[:0], td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(buf)
+}
+
+func BenchmarkAppendBase16Uint32(b *testing.B) {
+	opts := parseint.HexOptions{Width: 8}
+	fn := getAppendBenchmarkFn(b, func(dst []byte, u uint32) []byte {
+		return fmt.Appendf(dst, "%08x", u)
+	}, func(dst []byte, u uint32) []byte {
+		return parseint.AppendBase16Uint32(dst, u, opts)
+	})
+
+	buf := make([]byte, 0, 32)
+	for _, td := range []struct {
+		name  string
+		input uint32
+	}{
+		{"small", 0xab},
+		{"max", math.MaxUint32},
+	} {
+		b.Run(td.name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				buf = fn(buf[:0], td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(buf)
+}
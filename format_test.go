@@ -0,0 +1,248 @@
+package parseint_test
+
+import (
+	"math"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendBase10Int64(t *testing.T) {
+	check := func(i int64) {
+		expect := strconv.FormatInt(i, 10)
+		require.Equal(t, expect, string(parseint.AppendBase10Int64(nil, i)))
+		require.Equal(t, "x"+expect,
+			string(parseint.AppendBase10Int64([]byte("x"), i)))
+		require.Equal(t, expect, string(parseint.AppendBase10Int64(nil, int(i))))
+	}
+	for i := int64(-100_000); i <= 100_000; i++ {
+		check(i)
+	}
+	for p := int64(10); p <= math.MaxInt64/10; p *= 10 {
+		check(p - 1)
+		check(p)
+		check(p + 1)
+		check(-p - 1)
+		check(-p)
+		check(-p + 1)
+	}
+	for _, i := range []int64{
+		math.MaxInt64, math.MinInt64, math.MaxInt64 - 1, math.MinInt64 + 1,
+		math.MaxInt32, math.MinInt32, math.MaxUint32, -math.MaxUint32,
+		math.MaxUint32 + 1, -math.MaxUint32 - 1,
+	} {
+		check(i)
+	}
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100_000; n++ {
+		check(int64(r.Uint64() >> r.Intn(64)))
+	}
+}
+
+func TestAppendBase10Uint64(t *testing.T) {
+	check := func(u uint64) {
+		expect := strconv.FormatUint(u, 10)
+		require.Equal(t, expect, string(parseint.AppendBase10Uint64(nil, u)))
+		require.Equal(t, "x"+expect,
+			string(parseint.AppendBase10Uint64([]byte("x"), u)))
+		require.Equal(t, expect, string(parseint.AppendBase10Uint64(nil, uint(u))))
+	}
+	for u := uint64(0); u <= 100_000; u++ {
+		check(u)
+	}
+	for p := uint64(10); p <= math.MaxUint64/10; p *= 10 {
+		check(p - 1)
+		check(p)
+		check(p + 1)
+	}
+	check(math.MaxUint64)
+	check(math.MaxUint64 - 1)
+	check(math.MaxUint32)
+	check(math.MaxUint32 + 1)
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100_000; n++ {
+		check(r.Uint64() >> r.Intn(64))
+	}
+}
+
+func TestAppendBase10Int32(t *testing.T) {
+	for i := int64(-100_000); i <= 100_000; i++ {
+		require.Equal(t, strconv.FormatInt(i, 10),
+			string(parseint.AppendBase10Int32(nil, int32(i))))
+	}
+	for _, i := range []int32{math.MaxInt32, math.MinInt32, math.MinInt32 + 1} {
+		require.Equal(t, strconv.FormatInt(int64(i), 10),
+			string(parseint.AppendBase10Int32(nil, i)))
+	}
+	for i := math.MinInt8; i <= math.MaxInt8; i++ {
+		require.Equal(t, strconv.Itoa(i),
+			string(parseint.AppendBase10Int32(nil, int8(i))))
+	}
+	for i := math.MinInt16; i <= math.MaxInt16; i++ {
+		require.Equal(t, strconv.Itoa(i),
+			string(parseint.AppendBase10Int32(nil, int16(i))))
+	}
+}
+
+func TestAppendBase10Uint32(t *testing.T) {
+	for u := uint64(0); u <= 100_000; u++ {
+		require.Equal(t, strconv.FormatUint(u, 10),
+			string(parseint.AppendBase10Uint32(nil, uint32(u))))
+	}
+	require.Equal(t, "4294967295",
+		string(parseint.AppendBase10Uint32(nil, uint32(math.MaxUint32))))
+	require.Equal(t, "255", string(parseint.AppendBase10Uint32(nil, uint8(255))))
+	require.Equal(t, "65535", string(parseint.AppendBase10Uint32(nil, uint16(65535))))
+}
+
+func TestAppendBase10Allocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = parseint.AppendBase10Int64(buf[:0], int64(math.MinInt64))
+		buf = parseint.AppendBase10Uint64(buf, uint64(math.MaxUint64))
+		buf = parseint.AppendBase10Int32(buf, int32(math.MinInt32))
+		buf = parseint.AppendBase10Uint32(buf, uint32(math.MaxUint32))
+	})
+	require.Zero(t, allocs)
+}
+
+func FuzzAppendBase10Int64(f *testing.F) {
+	for _, i := range []int64{0, 1, -1, 99, 100, math.MaxInt64, math.MinInt64} {
+		f.Add(i)
+	}
+
+	f.Fuzz(func(t *testing.T, i int64) {
+		b := parseint.AppendBase10Int64(nil, i)
+		if s := strconv.FormatInt(i, 10); string(b) != s {
+			t.Fatalf("expected %q; received: %q", s, b)
+		}
+		if v, err := parseint.Base10Int64(b); err != nil || v != i {
+			t.Fatalf("round-trip %q: expected %d; received: %d, %v", b, i, v, err)
+		}
+		if v, err := parseint.Base10Int32[[]byte, int32](
+			parseint.AppendBase10Int32(nil, int32(i)),
+		); err != nil || v != int32(i) {
+			t.Fatalf("round-trip int32: expected %d; received: %d, %v", int32(i), v, err)
+		}
+	})
+}
+
+func FuzzAppendBase10Uint64(f *testing.F) {
+	for _, u := range []uint64{0, 1, 99, 100, math.MaxUint32, math.MaxUint64} {
+		f.Add(u)
+	}
+
+	f.Fuzz(func(t *testing.T, u uint64) {
+		b := parseint.AppendBase10Uint64(nil, u)
+		if s := strconv.FormatUint(u, 10); string(b) != s {
+			t.Fatalf("expected %q; received: %q", s, b)
+		}
+		if v, err := parseint.Base10Uint64(b); err != nil || v != u {
+			t.Fatalf("round-trip %q: expected %d; received: %d, %v", b, u, v, err)
+		}
+		if v, err := parseint.Base10Uint32[[]byte, uint32](
+			parseint.AppendBase10Uint32(nil, uint32(u)),
+		); err != nil || v != uint32(u) {
+			t.Fatalf("round-trip uint32: expected %d; received: %d, %v", uint32(u), v, err)
+		}
+	})
+}
+
+func BenchmarkAppendBase10Int64(b *testing.B) {
+	fn := getAppendBenchmarkFn(b, func(dst []byte, i int64) []byte {
+		return strconv.AppendInt(dst, i, 10)
+	}, parseint.AppendBase10Int64[int64])
+
+	buf := make([]byte, 0, 32)
+	for _, td := range []struct {
+		name  string
+		input int64
+	}{
+		{"0", 0},
+		{"small", 42},
+		{"neg", -12345},
+		{"u32", 3_000_000_000},
+		{"max", math.MaxInt64},
+		{"min", math.MinInt64},
+	} {
+		b.Run(td.name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				buf = fn(buf[:0], td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(buf)
+}
+
+func BenchmarkAppendBase10Uint64(b *testing.B) {
+	fn := getAppendBenchmarkFn(b, func(dst []byte, u uint64) []byte {
+		return strconv.AppendUint(dst, u, 10)
+	}, parseint.AppendBase10Uint64[uint64])
+
+	buf := make([]byte, 0, 32)
+	for _, td := range []struct {
+		name  string
+		input uint64
+	}{
+		{"0", 0},
+		{"small", 42},
+		{"u32", 3_000_000_000},
+		{"max", math.MaxUint64},
+	} {
+		b.Run(td.name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				buf = fn(buf[:0], td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(buf)
+}
+
+func BenchmarkAppendBase10Int32(b *testing.B) {
+	fn := getAppendBenchmarkFn(b, func(dst []byte, i int32) []byte {
+		return strconv.AppendInt(dst, int64(i), 10)
+	}, parseint.AppendBase10Int32[int32])
+
+	buf := make([]byte, 0, 32)
+	for _, td := range []struct {
+		name  string
+		input int32
+	}{
+		{"small", 42},
+		{"max", math.MaxInt32},
+		{"min", math.MinInt32},
+	} {
+		b.Run(td.name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				buf = fn(buf[:0], td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(buf)
+}
+
+func BenchmarkAppendBase10Uint32(b *testing.B) {
+	fn := getAppendBenchmarkFn(b, func(dst []byte, u uint32) []byte {
+		return strconv.AppendUint(dst, uint64(u), 10)
+	}, parseint.AppendBase10Uint32[uint32])
+
+	buf := make([]byte, 0, 32)
+	for _, td := range []struct {
+		name  string
+		input uint32
+	}{
+		{"small", 42},
+		{"max", math.MaxUint32},
+	} {
+		b.Run(td.name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				buf = fn(buf[:0], td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(buf)
+}
@@ -0,0 +1,100 @@
+package parseint
+
+// Grouping defines the accepted placement of digit grouping separators.
+type Grouping uint8
+
+const (
+	// GroupingWestern accepts groups of 3 digits with a leading group of
+	// 1 to 3 digits, for example "1,234,567".
+	GroupingWestern Grouping = iota
+
+	// GroupingIndian accepts a trailing group of 3 digits preceded by groups
+	// of 2 digits with a leading group of 1 or 2 digits, for example "12,34,567".
+	GroupingIndian
+
+	// GroupingLenient accepts separators between any two digits,
+	// for example "1,2345,67".
+	GroupingLenient
+)
+
+// Base10Int64Grouped parses s as a base-10 signed 64-bit integer in which
+// digits may be grouped by sep, for example "1,234,567", "1.234.567",
+// "1 234 567" or "12,34,567". sep must not be empty and must not contain digits.
+// Input without any separators is always accepted.
+// If s contains separators they must be placed according to grouping.
+// Returns ErrSyntax if s contains an invalid character or a misplaced separator.
+// Returns ErrOverflow if the stringified value overflows an int64.
+func Base10Int64Grouped[S string | []byte](
+	s S, sep string, grouping Grouping,
+) (int64, error) {
+	if len(s) == 0 || len(sep) == 0 {
+		return 0, ErrSyntax
+	}
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	max := uint64(1<<63 - 1)
+	if neg {
+		max = 1 << 63
+	}
+
+	var n uint64
+	groups := 0 // Number of separators.
+	g := 0      // Number of digits in the current group.
+	for i := 0; i < len(s); {
+		if c := s[i]; c >= '0' && c <= '9' {
+			d := uint64(c - '0')
+			if n > (max-d)/10 {
+				return 0, ErrOverflow
+			}
+			n = n*10 + d
+			g++
+			i++
+			continue
+		}
+		if !hasPrefix(s[i:], sep) || g == 0 { // Leading or double separator.
+			return 0, ErrSyntax
+		}
+		switch grouping {
+		case GroupingWestern:
+			if g > 3 || (groups > 0 && g != 3) {
+				return 0, ErrSyntax
+			}
+		case GroupingIndian:
+			if g > 2 || (groups > 0 && g != 2) {
+				return 0, ErrSyntax
+			}
+		}
+		groups++
+		g = 0
+		i += len(sep)
+	}
+	if g == 0 { // Trailing separator or no digits at all.
+		return 0, ErrSyntax
+	}
+	if groups > 0 && grouping != GroupingLenient && g != 3 {
+		return 0, ErrSyntax
+	}
+	if neg {
+		return int64(-n), nil
+	}
+	return int64(n), nil
+}
+
+// hasPrefix is equivalent to strings.HasPrefix and bytes.HasPrefix.
+func hasPrefix[S string | []byte](s S, prefix string) bool {
+	if len(s) < len(prefix) {
+		return false
+	}
+	for i := 0; i < len(prefix); i++ {
+		if s[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,216 @@
+package parseint_test
+
+import (
+	"math"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+type groupedInput struct {
+	Input    string
+	Sep      string
+	Grouping parseint.Grouping
+}
+
+var validBase10Int64Grouped = map[groupedInput]int64{
+	{"0", ",", parseint.GroupingWestern}:                          0,
+	{"1", ",", parseint.GroupingWestern}:                          1,
+	{"999", ",", parseint.GroupingWestern}:                        999,
+	{"1234567", ",", parseint.GroupingWestern}:                    1234567,
+	{"1,234", ",", parseint.GroupingWestern}:                      1234,
+	{"12,345", ",", parseint.GroupingWestern}:                     12345,
+	{"123,456", ",", parseint.GroupingWestern}:                    123456,
+	{"1,234,567", ",", parseint.GroupingWestern}:                  1234567,
+	{"-1,234,567", ",", parseint.GroupingWestern}:                 -1234567,
+	{"+1,234,567", ",", parseint.GroupingWestern}:                 1234567,
+	{"1.234.567", ".", parseint.GroupingWestern}:                  1234567,
+	{"1 234 567", " ", parseint.GroupingWestern}:                  1234567,
+	{"1 234 567", " ", parseint.GroupingWestern}:                  1234567,
+	{"1 234 567", " ", parseint.GroupingWestern}:                  1234567,
+	{"1'234'567", "'", parseint.GroupingWestern}:                  1234567,
+	{"000,001", ",", parseint.GroupingWestern}:                    1,
+	{"9,223,372,036,854,775,807", ",", parseint.GroupingWestern}:  math.MaxInt64,
+	{"-9,223,372,036,854,775,808", ",", parseint.GroupingWestern}: math.MinInt64,
+
+	{"1234567", ",", parseint.GroupingIndian}:                     1234567,
+	{"1,234", ",", parseint.GroupingIndian}:                       1234,
+	{"12,345", ",", parseint.GroupingIndian}:                      12345,
+	{"1,23,456", ",", parseint.GroupingIndian}:                    123456,
+	{"12,34,567", ",", parseint.GroupingIndian}:                   1234567,
+	{"-1,00,00,000", ",", parseint.GroupingIndian}:                -10000000,
+	{"92,23,37,20,36,85,47,75,807", ",", parseint.GroupingIndian}: math.MaxInt64,
+
+	{"1,2,3", ",", parseint.GroupingLenient}:      123,
+	{"1,2345,67", ",", parseint.GroupingLenient}:  1234567,
+	{"12,34,567", ",", parseint.GroupingLenient}:  1234567,
+	{"1,234,567", ",", parseint.GroupingLenient}:  1234567,
+	{"-1_000_000", "_", parseint.GroupingLenient}: -1000000,
+	{"1234567", ",", parseint.GroupingLenient}:    1234567,
+}
+
+var invalidBase10Int64Grouped = map[groupedInput]error{
+	{"", ",", parseint.GroupingWestern}:          parseint.ErrSyntax,
+	{"1", "", parseint.GroupingWestern}:          parseint.ErrSyntax,
+	{"-", ",", parseint.GroupingWestern}:         parseint.ErrSyntax,
+	{",", ",", parseint.GroupingWestern}:         parseint.ErrSyntax,
+	{",123", ",", parseint.GroupingWestern}:      parseint.ErrSyntax,
+	{"-,123", ",", parseint.GroupingWestern}:     parseint.ErrSyntax,
+	{"123,", ",", parseint.GroupingWestern}:      parseint.ErrSyntax,
+	{"1,,234", ",", parseint.GroupingWestern}:    parseint.ErrSyntax,
+	{"1,23", ",", parseint.GroupingWestern}:      parseint.ErrSyntax,
+	{"1,2345", ",", parseint.GroupingWestern}:    parseint.ErrSyntax,
+	{"1234,567", ",", parseint.GroupingWestern}:  parseint.ErrSyntax,
+	{"1,23,456", ",", parseint.GroupingWestern}:  parseint.ErrSyntax,
+	{"1.234", ",", parseint.GroupingWestern}:     parseint.ErrSyntax,
+	{"1,234.567", ",", parseint.GroupingWestern}: parseint.ErrSyntax,
+	{"1 234", " ", parseint.GroupingWestern}:     parseint.ErrSyntax,
+	{"1\xc2234", " ", parseint.GroupingWestern}:  parseint.ErrSyntax,
+	{" 1,234", ",", parseint.GroupingWestern}:    parseint.ErrSyntax,
+	{"--1,234", ",", parseint.GroupingWestern}:   parseint.ErrSyntax,
+
+	{"123,456", ",", parseint.GroupingIndian}:    parseint.ErrSyntax,
+	{"1,234,567", ",", parseint.GroupingIndian}:  parseint.ErrSyntax,
+	{"12,3,456", ",", parseint.GroupingIndian}:   parseint.ErrSyntax,
+	{"12,34,56", ",", parseint.GroupingIndian}:   parseint.ErrSyntax,
+	{"12,34,5678", ",", parseint.GroupingIndian}: parseint.ErrSyntax,
+	{"1,23,", ",", parseint.GroupingIndian}:      parseint.ErrSyntax,
+
+	{",1", ",", parseint.GroupingLenient}:   parseint.ErrSyntax,
+	{"1,", ",", parseint.GroupingLenient}:   parseint.ErrSyntax,
+	{"1,,2", ",", parseint.GroupingLenient}: parseint.ErrSyntax,
+
+	{"9,223,372,036,854,775,808", ",", parseint.GroupingWestern}:  parseint.ErrOverflow,
+	{"-9,223,372,036,854,775,809", ",", parseint.GroupingWestern}: parseint.ErrOverflow,
+	{"99,999,999,999,999,999,999", ",", parseint.GroupingWestern}: parseint.ErrOverflow,
+}
+
+func TestBase10Int64Grouped(t *testing.T) {
+	callBase10Int64Grouped := func(in groupedInput, fn func(int64, error)) {
+		fn(parseint.Base10Int64Grouped(in.Input, in.Sep, in.Grouping))
+		fn(parseint.Base10Int64Grouped([]byte(in.Input), in.Sep, in.Grouping))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range validBase10Int64Grouped {
+			callBase10Int64Grouped(input, func(actual int64, err error) {
+				require.NoError(t, err, "%#v", input)
+				require.Equal(t, expect, actual, "%#v", input)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range invalidBase10Int64Grouped {
+			callBase10Int64Grouped(input, func(a int64, err error) {
+				require.ErrorIs(t, err, expectedErr, "%#v", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+
+	t.Run("range_western_100k", func(t *testing.T) {
+		for i := int64(-100_000); i <= 100_000; i++ {
+			in := groupedInput{groupWestern(i), ",", parseint.GroupingWestern}
+			callBase10Int64Grouped(in, func(actual int64, err error) {
+				require.NoError(t, err, "%#v", in)
+				require.Equal(t, i, actual, "%#v", in)
+			})
+		}
+	})
+}
+
+// groupWestern formats i with a comma separating every group of 3 digits.
+func groupWestern(i int64) string {
+	s := strconv.FormatInt(i, 10)
+	sign := ""
+	if s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, c := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+func FuzzBase10Int64Grouped(f *testing.F) {
+	for input := range validBase10Int64Grouped {
+		f.Add(input.Input)
+	}
+	for input := range invalidBase10Int64Grouped {
+		f.Add(input.Input)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		x, err := parseint.Base10Int64Grouped(s, ",", parseint.GroupingWestern)
+		if err != nil {
+			if x != 0 {
+				t.Errorf("%q: failed but returned non-zero value: %x", s, x)
+			}
+			if err == parseint.ErrOverflow {
+				_, errStd := strconv.ParseInt(strings.ReplaceAll(s, ",", ""), 10, 64)
+				if errStd == nil {
+					t.Fatalf("unexpected error for input %q: %v", s, err)
+				}
+			}
+			return
+		}
+		// Either ungrouped or correctly grouped.
+		std, errStd := strconv.ParseInt(strings.ReplaceAll(s, ",", ""), 10, 64)
+		if errStd != nil {
+			t.Fatalf("must have returned error %v but didn't: %q", errStd, s)
+		} else if std != x {
+			t.Errorf("expected %d; received: %d", std, x)
+		}
+		if strings.Contains(s, ",") {
+			digits := strings.TrimLeft(strings.ReplaceAll(s, ",", ""), "+-")
+			sign := s[:len(s)-len(strings.TrimLeft(s, "+-"))]
+			expect := sign
+			for i, c := range digits {
+				if i > 0 && (len(digits)-i)%3 == 0 {
+					expect += ","
+				}
+				expect += string(c)
+			}
+			if expect != s {
+				t.Fatalf("misplaced separator accepted: %q", s)
+			}
+		}
+	})
+}
+
+func BenchmarkBase10Int64Grouped(b *testing.B) {
+	fn := getBenchmarkFn(b, func(s string) (int64, error) {
+		return strconv.ParseInt(strings.ReplaceAll(s, ",", ""), 10, 64)
+	}, func(s string) (int64, error) {
+		return parseint.Base10Int64Grouped(s, ",", parseint.GroupingWestern)
+	})
+
+	var a int64
+	var err error
+	for _, td := range []struct {
+		name  string
+		input string
+	}{
+		{"small", "1,234"},
+		{"max", "9,223,372,036,854,775,807"},
+		{"syntax", "1,23,456"},
+	} {
+		b.Run(td.name+"/string", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				a, err = fn(td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
@@ -0,0 +1,217 @@
+package parseint
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+// Uint128 is an unsigned 128-bit integer consisting of
+// the high and low 64 bits.
+type Uint128 struct{ Hi, Lo uint64 }
+
+// Int128 is a signed 128-bit integer in two's complement representation
+// consisting of the high and low 64 bits.
+type Int128 struct {
+	Hi int64
+	Lo uint64
+}
+
+// String returns the base-10 representation of u.
+func (u Uint128) String() string { return string(u.Append(nil)) }
+
+// Append appends the base-10 representation of u to dst
+// and returns the extended buffer.
+func (u Uint128) Append(dst []byte) []byte {
+	if u.Hi == 0 {
+		return strconv.AppendUint(dst, u.Lo, 10)
+	}
+	// Split off the lowest 19 digits and format them zero-padded.
+	const e19 = 10_000_000_000_000_000_000
+	q := Uint128{Hi: u.Hi / e19}
+	var r uint64
+	q.Lo, r = bits.Div64(u.Hi%e19, u.Lo, e19)
+	dst = q.Append(dst)
+	var buf [19]byte
+	for i := len(buf) - 1; i >= 0; i-- {
+		buf[i] = byte(r%10) + '0'
+		r /= 10
+	}
+	return append(dst, buf[:]...)
+}
+
+// String returns the base-10 representation of i.
+func (i Int128) String() string { return string(i.Append(nil)) }
+
+// Append appends the base-10 representation of i to dst
+// and returns the extended buffer.
+func (i Int128) Append(dst []byte) []byte {
+	u := Uint128{Hi: uint64(i.Hi), Lo: i.Lo}
+	if i.Hi < 0 {
+		dst = append(dst, '-')
+		u = u.neg()
+	}
+	return u.Append(dst)
+}
+
+// neg returns the two's complement negation of u.
+func (u Uint128) neg() Uint128 {
+	lo, borrow := bits.Sub64(0, u.Lo, 0)
+	hi, _ := bits.Sub64(0, u.Hi, borrow)
+	return Uint128{Hi: hi, Lo: lo}
+}
+
+// mulAdd returns u*m+a and overflow=true if the result overflows a Uint128.
+func (u Uint128) mulAdd(m, a uint64) (r Uint128, overflow bool) {
+	hh, hl := bits.Mul64(u.Hi, m)
+	lh, ll := bits.Mul64(u.Lo, m)
+	hi, c1 := bits.Add64(hl, lh, 0)
+	lo, c2 := bits.Add64(ll, a, 0)
+	hi, c3 := bits.Add64(hi, 0, c2)
+	return Uint128{Hi: hi, Lo: lo}, hh|c1|c3 != 0
+}
+
+// Base10Uint128 parses s as a base-10 unsigned 128-bit integer.
+// Returns ErrSyntax if s contains an invalid character.
+// Returns ErrOverflow if the stringified value overflows a Uint128.
+func Base10Uint128[S string | []byte](s S) (Uint128, error) {
+	if len(s) == 0 {
+		return Uint128{}, ErrSyntax
+	}
+	var n Uint128
+	var overflow bool
+	for len(s) > 7 { // Process 8 digits at a time as long as possible.
+		c0, c1, c2, c3, c4, c5, c6, c7 := s[0], s[1], s[2], s[3], s[4], s[5], s[6], s[7]
+		if c0 < '0' || c0 > '9' || c1 < '0' || c1 > '9' ||
+			c2 < '0' || c2 > '9' || c3 < '0' || c3 > '9' ||
+			c4 < '0' || c4 > '9' || c5 < '0' || c5 > '9' ||
+			c6 < '0' || c6 > '9' || c7 < '0' || c7 > '9' {
+			return Uint128{}, ErrSyntax
+		}
+		d := uint64(c0-'0')*10_000_000 +
+			uint64(c1-'0')*1_000_000 +
+			uint64(c2-'0')*100_000 +
+			uint64(c3-'0')*10_000 +
+			uint64(c4-'0')*1_000 +
+			uint64(c5-'0')*100 +
+			uint64(c6-'0')*10 +
+			uint64(c7-'0')
+		if n, overflow = n.mulAdd(100_000_000, d); overflow {
+			return Uint128{}, ErrOverflow
+		}
+		s = s[8:]
+	}
+	for len(s) > 3 { // Process 4 digits at a time as long as possible.
+		c0, c1, c2, c3 := s[0], s[1], s[2], s[3]
+		if c0 < '0' || c0 > '9' || c1 < '0' || c1 > '9' ||
+			c2 < '0' || c2 > '9' || c3 < '0' || c3 > '9' {
+			return Uint128{}, ErrSyntax
+		}
+		d := uint64(c0-'0')*1_000 +
+			uint64(c1-'0')*100 +
+			uint64(c2-'0')*10 +
+			uint64(c3-'0')
+		if n, overflow = n.mulAdd(10_000, d); overflow {
+			return Uint128{}, ErrOverflow
+		}
+		s = s[4:]
+	}
+	for _, c := range []byte(s) { // Process remaining digits one at a time.
+		if c < '0' || c > '9' {
+			return Uint128{}, ErrSyntax
+		}
+		if n, overflow = n.mulAdd(10, uint64(c-'0')); overflow {
+			return Uint128{}, ErrOverflow
+		}
+	}
+	return n, nil
+}
+
+// Base10Int128 parses s as a base-10 signed 128-bit integer.
+// Returns ErrSyntax if s contains an invalid character.
+// Returns ErrOverflow if the stringified value overflows an Int128.
+func Base10Int128[S string | []byte](s S) (Int128, error) {
+	if len(s) == 0 {
+		return Int128{}, ErrSyntax
+	}
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	u, err := Base10Uint128(s)
+	if err != nil {
+		return Int128{}, err
+	}
+	return int128FromMagnitude(u, neg)
+}
+
+// Base16Uint128 parses s as a base-16 (hexadecimal) unsigned 128-bit integer.
+// Leading zeroes are skipped.
+// Returns ErrSyntax if s contains an invalid character.
+// Returns ErrOverflow if the stringified value overflows a Uint128.
+func Base16Uint128[S string | []byte](s S) (Uint128, error) {
+	for len(s) > 1 && s[0] == '0' { // Skip all leading zeroes if any.
+		s = s[1:]
+	}
+	if len(s) <= 16 {
+		lo, err := base16Uint64(s)
+		return Uint128{Lo: lo}, err
+	}
+	if len(s) > 32 {
+		for _, c := range []byte(s) {
+			if lutHex[c] == invalidHexByte {
+				return Uint128{}, ErrSyntax
+			}
+		}
+		return Uint128{}, ErrOverflow
+	}
+	hi, err := base16Uint64(s[:len(s)-16])
+	if err != nil {
+		return Uint128{}, err
+	}
+	lo, err := base16Uint64(s[len(s)-16:])
+	if err != nil {
+		return Uint128{}, err
+	}
+	return Uint128{Hi: hi, Lo: lo}, nil
+}
+
+// Base16Int128 parses s as a base-16 (hexadecimal) signed 128-bit integer
+// with an optional '-' or '+' sign.
+// Returns ErrSyntax if s contains an invalid character.
+// Returns ErrOverflow if the stringified value overflows an Int128.
+func Base16Int128[S string | []byte](s S) (Int128, error) {
+	if len(s) == 0 {
+		return Int128{}, ErrSyntax
+	}
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	u, err := Base16Uint128(s)
+	if err != nil {
+		return Int128{}, err
+	}
+	return int128FromMagnitude(u, neg)
+}
+
+// int128FromMagnitude returns the Int128 of magnitude u.
+// Returns ErrOverflow if u is out of range.
+func int128FromMagnitude(u Uint128, neg bool) (Int128, error) {
+	if neg {
+		if u.Hi > 1<<63 || (u.Hi == 1<<63 && u.Lo != 0) {
+			return Int128{}, ErrOverflow
+		}
+		u = u.neg()
+	} else if u.Hi > 1<<63-1 {
+		return Int128{}, ErrOverflow
+	}
+	return Int128{Hi: int64(u.Hi), Lo: u.Lo}, nil
+}
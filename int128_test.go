@@ -0,0 +1,259 @@
+package parseint_test
+
+import (
+	"math/big"
+	"math/rand"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+var validBase10Uint128 = map[string]parseint.Uint128{
+	"0":                    {},
+	"1":                    {Lo: 1},
+	"0001":                 {Lo: 1},
+	"18446744073709551615": {Lo: 1<<64 - 1},
+	"18446744073709551616": {Hi: 1},
+	"340282366920938463463374607431768211455": {Hi: 1<<64 - 1, Lo: 1<<64 - 1},
+	"0000340282366920938463463374607431768211455": {
+		Hi: 1<<64 - 1, Lo: 1<<64 - 1,
+	},
+	"170141183460469231731687303715884105728": {Hi: 1 << 63},
+}
+
+var invalidBase10Uint128 = map[string]error{
+	"":                                       parseint.ErrSyntax,
+	"-":                                      parseint.ErrSyntax,
+	"+1":                                     parseint.ErrSyntax,
+	"-1":                                     parseint.ErrSyntax,
+	" 1":                                     parseint.ErrSyntax,
+	"1x":                                     parseint.ErrSyntax,
+	"ж":                                      parseint.ErrSyntax,
+	"1.0":                                    parseint.ErrSyntax,
+	"1234567890123456789012345678901234567x": parseint.ErrSyntax,
+
+	"340282366920938463463374607431768211456":  parseint.ErrOverflow,
+	"340282366920938463463374607431768211460":  parseint.ErrOverflow,
+	"999999999999999999999999999999999999999":  parseint.ErrOverflow,
+	"1000000000000000000000000000000000000000": parseint.ErrOverflow,
+}
+
+func TestBase10Uint128(t *testing.T) {
+	callBase10Uint128 := func(input string, fn func(parseint.Uint128, error)) {
+		fn(parseint.Base10Uint128(input))
+		fn(parseint.Base10Uint128([]byte(input)))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range validBase10Uint128 {
+			callBase10Uint128(input, func(actual parseint.Uint128, err error) {
+				require.NoError(t, err, "%q", input)
+				require.Equal(t, expect, actual, "%q", input)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range invalidBase10Uint128 {
+			callBase10Uint128(input, func(a parseint.Uint128, err error) {
+				require.ErrorIs(t, err, expectedErr, "%q", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+
+	t.Run("random", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < 10_000; i++ {
+			expect := parseint.Uint128{Hi: r.Uint64() >> r.Intn(64), Lo: r.Uint64()}
+			b := uint128ToBig(expect)
+			callBase10Uint128(b.String(), func(actual parseint.Uint128, err error) {
+				require.NoError(t, err)
+				require.Equal(t, expect, actual)
+			})
+			hex := b.Text(16)
+			actual, err := parseint.Base16Uint128(hex)
+			require.NoError(t, err)
+			require.Equal(t, expect, actual)
+			require.Equal(t, b.String(), expect.String())
+		}
+	})
+}
+
+func TestBase10Int128(t *testing.T) {
+	for input, expect := range map[string]parseint.Int128{
+		"0":                     {},
+		"-0":                    {},
+		"+1":                    {Lo: 1},
+		"-1":                    {Hi: -1, Lo: 1<<64 - 1},
+		"-2":                    {Hi: -1, Lo: 1<<64 - 2},
+		"18446744073709551616":  {Hi: 1},
+		"-18446744073709551616": {Hi: -1},
+		"170141183460469231731687303715884105727":  {Hi: 1<<63 - 1, Lo: 1<<64 - 1},
+		"-170141183460469231731687303715884105728": {Hi: -1 << 63},
+	} {
+		actual, err := parseint.Base10Int128(input)
+		require.NoError(t, err, "%q", input)
+		require.Equal(t, expect, actual, "%q", input)
+		actual, err = parseint.Base10Int128([]byte(input))
+		require.NoError(t, err, "%q", input)
+		require.Equal(t, expect, actual, "%q", input)
+		b, _ := new(big.Int).SetString(input, 10)
+		require.Equal(t, b.String(), actual.String())
+	}
+
+	for input, expectedErr := range map[string]error{
+		"":    parseint.ErrSyntax,
+		"-":   parseint.ErrSyntax,
+		"+":   parseint.ErrSyntax,
+		"--1": parseint.ErrSyntax,
+		"1x":  parseint.ErrSyntax,
+		"170141183460469231731687303715884105728":  parseint.ErrOverflow,
+		"-170141183460469231731687303715884105729": parseint.ErrOverflow,
+		"340282366920938463463374607431768211456":  parseint.ErrOverflow,
+		"-340282366920938463463374607431768211455": parseint.ErrOverflow,
+	} {
+		actual, err := parseint.Base10Int128(input)
+		require.ErrorIs(t, err, expectedErr, "%q", input)
+		require.Zero(t, actual)
+	}
+}
+
+func TestBase16Uint128(t *testing.T) {
+	for input, expect := range map[string]parseint.Uint128{
+		"0":                                {},
+		"000":                              {},
+		"f":                                {Lo: 0xf},
+		"ffffffffffffffff":                 {Lo: 1<<64 - 1},
+		"10000000000000000":                {Hi: 1},
+		"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF": {Hi: 1<<64 - 1, Lo: 1<<64 - 1},
+		"0000ffffffffffffffffffffffffffffffff": {
+			Hi: 1<<64 - 1, Lo: 1<<64 - 1,
+		},
+		"20010db8000000000000000000000001": {Hi: 0x20010db800000000, Lo: 1},
+		"123456789abcdef0123456789abcdef":  {Hi: 0x123456789abcdef, Lo: 0x0123456789abcdef},
+	} {
+		actual, err := parseint.Base16Uint128(input)
+		require.NoError(t, err, "%q", input)
+		require.Equal(t, expect, actual, "%q", input)
+		actual, err = parseint.Base16Uint128([]byte(input))
+		require.NoError(t, err, "%q", input)
+		require.Equal(t, expect, actual, "%q", input)
+	}
+
+	for input, expectedErr := range map[string]error{
+		"":                                  parseint.ErrSyntax,
+		"-1":                                parseint.ErrSyntax,
+		"0x1":                               parseint.ErrSyntax,
+		"g":                                 parseint.ErrSyntax,
+		"ffffffffffffffffffffffffffffffffg": parseint.ErrSyntax,
+		"fffffffffffffffffffffffffffffffg":  parseint.ErrSyntax,
+		"100000000000000000000000000000000": parseint.ErrOverflow,
+	} {
+		actual, err := parseint.Base16Uint128(input)
+		require.ErrorIs(t, err, expectedErr, "%q", input)
+		require.Zero(t, actual)
+	}
+}
+
+func TestBase16Int128(t *testing.T) {
+	for input, expect := range map[string]parseint.Int128{
+		"0":                                 {},
+		"-1":                                {Hi: -1, Lo: 1<<64 - 1},
+		"+7fffffffffffffffffffffffffffffff": {Hi: 1<<63 - 1, Lo: 1<<64 - 1},
+		"-80000000000000000000000000000000": {Hi: -1 << 63},
+	} {
+		actual, err := parseint.Base16Int128(input)
+		require.NoError(t, err, "%q", input)
+		require.Equal(t, expect, actual, "%q", input)
+	}
+
+	for input, expectedErr := range map[string]error{
+		"":                                  parseint.ErrSyntax,
+		"-":                                 parseint.ErrSyntax,
+		"-+1":                               parseint.ErrSyntax,
+		"80000000000000000000000000000000":  parseint.ErrOverflow,
+		"-80000000000000000000000000000001": parseint.ErrOverflow,
+	} {
+		actual, err := parseint.Base16Int128(input)
+		require.ErrorIs(t, err, expectedErr, "%q", input)
+		require.Zero(t, actual)
+	}
+}
+
+func uint128ToBig(u parseint.Uint128) *big.Int {
+	b := new(big.Int).SetUint64(u.Hi)
+	b.Lsh(b, 64)
+	return b.Or(b, new(big.Int).SetUint64(u.Lo))
+}
+
+var maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+func FuzzBase10Uint128(f *testing.F) {
+	for input := range validBase10Uint128 {
+		f.Add(input)
+	}
+	for input := range invalidBase10Uint128 {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		x, err := parseint.Base10Uint128(s)
+		isDigits := s != "" && strings.Trim(s, "0123456789") == ""
+		std, ok := new(big.Int).SetString(s, 10)
+		switch {
+		case err == nil:
+			if !isDigits || !ok {
+				t.Fatalf("must have returned error but didn't: %q", s)
+			} else if std.Cmp(uint128ToBig(x)) != 0 {
+				t.Errorf("expected %s; received: %s", std, x)
+			} else if x.String() != std.String() {
+				t.Errorf("expected String %s; received: %s", std, x)
+			}
+		case x != (parseint.Uint128{}):
+			t.Errorf("%q: failed but returned non-zero value: %s", s, x)
+		case err == parseint.ErrOverflow:
+			if ok && std.Cmp(maxUint128) <= 0 {
+				t.Fatalf("unexpected error for input %q: %v", s, err)
+			}
+		case isDigits:
+			t.Fatalf("unexpected error for input %q: %v", s, err)
+		}
+	})
+}
+
+func BenchmarkBase10Uint128(b *testing.B) {
+	// Uses math/big as the reference since strconv doesn't support 128-bit.
+	fn := getBenchmarkFn(b, func(s string) (parseint.Uint128, error) {
+		x, ok := new(big.Int).SetString(s, 10)
+		if !ok || x.Sign() < 0 || x.BitLen() > 128 {
+			return parseint.Uint128{}, parseint.ErrSyntax
+		}
+		lo := x.Uint64()
+		return parseint.Uint128{Hi: x.Rsh(x, 64).Uint64(), Lo: lo}, nil
+	}, parseint.Base10Uint128[string])
+
+	var a parseint.Uint128
+	var err error
+	for _, td := range []struct {
+		name  string
+		input string
+	}{
+		{"small", "12345"},
+		{"u64max", "18446744073709551615"},
+		{"max", "340282366920938463463374607431768211455"},
+		{"syntax", "34028236692093846346337460743176821145x"},
+		{"overflow", "340282366920938463463374607431768211456"},
+	} {
+		b.Run(td.name+"/string", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				a, err = fn(td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
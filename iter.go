@@ -0,0 +1,57 @@
+//go:build go1.23
+
+package parseint
+
+import (
+	"iter"
+	"strconv"
+)
+
+// OffsetError records an error and the byte offset of the token
+// at which it occurred.
+type OffsetError struct {
+	Offset int   // Byte offset of the token.
+	Err    error // ErrSyntax or ErrOverflow.
+}
+
+func (e *OffsetError) Error() string {
+	return "offset " + strconv.Itoa(e.Offset) + ": " + e.Err.Error()
+}
+
+func (e *OffsetError) Unwrap() error { return e.Err }
+
+// IntToken is an integer yielded by Ints
+// together with the byte offset of its token.
+type IntToken struct {
+	Value  int64
+	Offset int // Byte offset of the token.
+}
+
+// Ints returns an iterator over the integers in s separated by ASCII whitespace,
+// each parsed with Base10Int64 and yielded with the byte offset of its token.
+// A token that fails to parse yields a zero Value and an *OffsetError wrapping
+// ErrSyntax or ErrOverflow, after which iteration continues with the next token.
+// Iterating doesn't allocate unless an error is yielded.
+func Ints[S string | []byte](s S) iter.Seq2[IntToken, error] {
+	return func(yield func(IntToken, error) bool) {
+		for i := 0; i < len(s); {
+			for i < len(s) && asciiSpace[s[i]] {
+				i++
+			}
+			if i == len(s) {
+				return
+			}
+			start := i
+			for i < len(s) && !asciiSpace[s[i]] {
+				i++
+			}
+			v, err := Base10Int64(s[start:i])
+			if err != nil {
+				err = &OffsetError{Offset: start, Err: err}
+			}
+			if !yield(IntToken{Value: v, Offset: start}, err) {
+				return
+			}
+		}
+	}
+}
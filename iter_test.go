@@ -0,0 +1,121 @@
+//go:build go1.23
+
+package parseint_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInts(t *testing.T) {
+	type result struct {
+		V      int64
+		Offset int
+		Err    error
+	}
+	for input, expect := range map[string][]result{
+		"":         nil,
+		" \t\n\r ": nil,
+		"0":        {{V: 0}},
+		"1 -2 +3":  {{V: 1}, {V: -2, Offset: 2}, {V: 3, Offset: 5}},
+		"\n 9223372036854775807\t-9223372036854775808\n": {
+			{V: math.MaxInt64, Offset: 2}, {V: math.MinInt64, Offset: 22},
+		},
+		"1 x 9223372036854775808 4": {
+			{V: 1},
+			{Offset: 2, Err: parseint.ErrSyntax},
+			{Offset: 4, Err: parseint.ErrOverflow},
+			{V: 4, Offset: 24},
+		},
+		"1,2 3": {{Offset: 0, Err: parseint.ErrSyntax}, {V: 3, Offset: 4}},
+	} {
+		check := func(seq func(func(parseint.IntToken, error) bool)) {
+			var actual []result
+			for tok, err := range seq {
+				r := result{V: tok.Value, Offset: tok.Offset}
+				if err != nil {
+					var oe *parseint.OffsetError
+					require.True(t, errors.As(err, &oe), "%q", input)
+					require.Equal(t, tok.Offset, oe.Offset, "%q", input)
+					r.Err = oe.Err
+				}
+				actual = append(actual, r)
+			}
+			require.Equal(t, expect, actual, "%q", input)
+		}
+		check(parseint.Ints(input))
+		check(parseint.Ints([]byte(input)))
+	}
+}
+
+func TestIntsBreak(t *testing.T) {
+	var actual []int64
+	for tok, err := range parseint.Ints("1 2 3 4") {
+		require.NoError(t, err)
+		if tok.Value == 3 {
+			break
+		}
+		actual = append(actual, tok.Value)
+	}
+	require.Equal(t, []int64{1, 2}, actual)
+}
+
+func TestIntsOffsetError(t *testing.T) {
+	var errs []error
+	for _, err := range parseint.Ints("12 1x") {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], parseint.ErrSyntax)
+	var oe *parseint.OffsetError
+	require.True(t, errors.As(errs[0], &oe))
+	require.Equal(t, 3, oe.Offset)
+	require.Equal(t, "offset 3: syntax error", errs[0].Error())
+}
+
+func TestIntsAllocs(t *testing.T) {
+	input := []byte(strings.Repeat("12345 -67890\n", 100))
+	var sum int64
+	allocs := testing.AllocsPerRun(100, func() {
+		for tok, err := range parseint.Ints(input) {
+			if err != nil {
+				panic(err)
+			}
+			sum += tok.Value
+		}
+	})
+	require.Zero(t, allocs)
+}
+
+func BenchmarkInts(b *testing.B) {
+	input := []byte(strings.Repeat("12345 -67890 9223372036854775807\n", 1000))
+
+	fn := getBenchmarkImpl(b, func(input []byte) error {
+		for _, f := range strings.Fields(string(input)) {
+			if _, err := strconv.ParseInt(f, 10, 64); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(input []byte) error {
+		for _, err := range parseint.Ints(input) {
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	for n := 0; n < b.N; n++ {
+		if err := fn(input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
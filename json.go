@@ -0,0 +1,123 @@
+package parseint
+
+import "errors"
+
+// ErrNotInteger is returned when the input is a syntactically valid number
+// that isn't an integer because it contains a fraction or an exponent.
+var ErrNotInteger = errors.New("not an integer")
+
+// JSONInt64 parses s as a signed 64-bit integer following the strict JSON
+// number grammar defined in RFC 8259: an optional '-' followed by either
+// a single '0' or a non-zero digit followed by any number of digits.
+// A leading '+' and leading zeroes are rejected.
+// "-0" is a valid JSON number and is accepted unless rejectNegZero is true.
+// Returns ErrNotInteger if s is a valid JSON number containing a fraction
+// or an exponent (for example "1.0" or "1e3").
+// Returns ErrSyntax if s isn't a valid JSON number.
+// Returns ErrOverflow if the stringified value overflows an int64.
+func JSONInt64[S string | []byte](s S, rejectNegZero bool) (int64, error) {
+	if len(s) == 0 {
+		return 0, ErrSyntax
+	}
+	i := 0
+	if s[0] == '-' {
+		if len(s) == 1 { // Sign without any following digits.
+			return 0, ErrSyntax
+		}
+		i = 1
+	}
+	switch c := s[i]; {
+	case c == '0':
+		if len(s) == i+1 {
+			if i == 1 && rejectNegZero {
+				return 0, ErrSyntax
+			}
+			return 0, nil
+		}
+		// Leading zeroes are only allowed for numbers with fraction or exponent.
+		return 0, jsonNonIntegerErr(s[i+1:])
+	case c < '1' || c > '9':
+		return 0, ErrSyntax
+	}
+	v, err := Base10Int64(s)
+	if err != nil {
+		return 0, jsonErr(s[i+1:], err)
+	}
+	return v, nil
+}
+
+// JSONUint64 parses s as an unsigned 64-bit integer following the strict JSON
+// number grammar defined in RFC 8259. It behaves like JSONInt64 except that
+// any sign, including "-0", is rejected with ErrSyntax.
+// Returns ErrOverflow if the stringified value overflows a uint64.
+func JSONUint64[S string | []byte](s S) (uint64, error) {
+	if len(s) == 0 {
+		return 0, ErrSyntax
+	}
+	switch c := s[0]; {
+	case c == '0':
+		if len(s) == 1 {
+			return 0, nil
+		}
+		return 0, jsonNonIntegerErr(s[1:])
+	case c < '1' || c > '9':
+		return 0, ErrSyntax
+	}
+	v, err := Base10Uint64(s)
+	if err != nil {
+		return 0, jsonErr(s[1:], err)
+	}
+	return v, nil
+}
+
+// jsonErr returns the error of a JSON number given the remainder s following
+// its first digit and the error err of parsing it as an integer.
+// Only the first digit is validated before parsing, the remainder is
+// scanned only if parsing fails so that valid input is scanned once.
+func jsonErr[S string | []byte](s S, err error) error {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i != len(s) {
+		// Base10Int64 may report an overflow before reaching a fraction,
+		// an exponent or an invalid character.
+		return jsonNonIntegerErr(s[i:])
+	}
+	return err
+}
+
+// jsonNonIntegerErr returns ErrNotInteger if s is a valid JSON number fraction
+// and/or exponent (the part of a JSON number that follows the integer part),
+// otherwise returns ErrSyntax.
+func jsonNonIntegerErr[S string | []byte](s S) error {
+	i := 0
+	if s[0] == '.' {
+		i = 1
+		if i == len(s) || s[i] < '0' || s[i] > '9' {
+			return ErrSyntax // Fraction without digits.
+		}
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == len(s) {
+			return ErrNotInteger
+		}
+	}
+	if s[i] != 'e' && s[i] != 'E' {
+		return ErrSyntax
+	}
+	i++
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	if i == len(s) {
+		return ErrSyntax // Exponent without digits.
+	}
+	for ; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return ErrSyntax
+		}
+	}
+	return ErrNotInteger
+}
@@ -0,0 +1,248 @@
+package parseint_test
+
+import (
+	"math"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+var validJSONInt64 = map[string]int64{
+	"0":                    0,
+	"-0":                   0,
+	"1":                    1,
+	"-1":                   -1,
+	"10":                   10,
+	"123":                  123,
+	"-123":                 -123,
+	"1234567890":           1234567890,
+	"9223372036854775807":  math.MaxInt64,
+	"-9223372036854775808": math.MinInt64,
+}
+
+var invalidJSONInt64 = map[string]error{
+	"":      parseint.ErrSyntax,
+	" ":     parseint.ErrSyntax,
+	" 1":    parseint.ErrSyntax,
+	"1 ":    parseint.ErrSyntax,
+	"-":     parseint.ErrSyntax,
+	"+":     parseint.ErrSyntax,
+	"+1":    parseint.ErrSyntax,
+	"+0":    parseint.ErrSyntax,
+	"--1":   parseint.ErrSyntax,
+	"-+1":   parseint.ErrSyntax,
+	"00":    parseint.ErrSyntax,
+	"01":    parseint.ErrSyntax,
+	"007":   parseint.ErrSyntax,
+	"-01":   parseint.ErrSyntax,
+	"-00":   parseint.ErrSyntax,
+	"0x1":   parseint.ErrSyntax,
+	"123x":  parseint.ErrSyntax,
+	"1.":    parseint.ErrSyntax,
+	".1":    parseint.ErrSyntax,
+	"-.1":   parseint.ErrSyntax,
+	"1.e1":  parseint.ErrSyntax,
+	"1e":    parseint.ErrSyntax,
+	"1e+":   parseint.ErrSyntax,
+	"1e-":   parseint.ErrSyntax,
+	"1e1.0": parseint.ErrSyntax,
+	"1.0.0": parseint.ErrSyntax,
+	"1.0x":  parseint.ErrSyntax,
+	"1ee1":  parseint.ErrSyntax,
+	"NaN":   parseint.ErrSyntax,
+	"ж":     parseint.ErrSyntax,
+
+	"0.0":                       parseint.ErrNotInteger,
+	"-0.0":                      parseint.ErrNotInteger,
+	"1.0":                       parseint.ErrNotInteger,
+	"1.5":                       parseint.ErrNotInteger,
+	"-1.5":                      parseint.ErrNotInteger,
+	"1e3":                       parseint.ErrNotInteger,
+	"1E3":                       parseint.ErrNotInteger,
+	"1e+3":                      parseint.ErrNotInteger,
+	"1e-3":                      parseint.ErrNotInteger,
+	"0e0":                       parseint.ErrNotInteger,
+	"-0E-0":                     parseint.ErrNotInteger,
+	"1.25e10":                   parseint.ErrNotInteger,
+	"99999999999999999999999.5": parseint.ErrNotInteger,
+
+	"9223372036854775808":   parseint.ErrOverflow,
+	"-9223372036854775809":  parseint.ErrOverflow,
+	"99999999999999999999":  parseint.ErrOverflow,
+	"-99999999999999999999": parseint.ErrOverflow,
+}
+
+// jsonNumber is the RFC 8259 number grammar.
+var jsonNumber = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+func TestJSONInt64(t *testing.T) {
+	callJSONInt64 := func(input string, fn func(any, error)) {
+		fn(parseint.JSONInt64(input, false))
+		fn(parseint.JSONInt64([]byte(input), false))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range validJSONInt64 {
+			callJSONInt64(input, func(actual any, err error) {
+				require.NoError(t, err, "%q", input)
+				require.Equal(t, expect, actual, "%q", input)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range invalidJSONInt64 {
+			callJSONInt64(input, func(a any, err error) {
+				require.ErrorIs(t, err, expectedErr, "%q", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+
+	t.Run("reject_neg_zero", func(t *testing.T) {
+		_, err := parseint.JSONInt64("-0", true)
+		require.ErrorIs(t, err, parseint.ErrSyntax)
+		_, err = parseint.JSONInt64([]byte("-0"), true)
+		require.ErrorIs(t, err, parseint.ErrSyntax)
+
+		v, err := parseint.JSONInt64("0", true)
+		require.NoError(t, err)
+		require.Zero(t, v)
+		v, err = parseint.JSONInt64("-1", true)
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), v)
+		_, err = parseint.JSONInt64("-0.5", true)
+		require.ErrorIs(t, err, parseint.ErrNotInteger)
+	})
+
+	t.Run("range_0_10k", func(t *testing.T) {
+		for i := int64(-10_000); i <= 10_000; i++ {
+			v, err := parseint.JSONInt64(strconv.FormatInt(i, 10), false)
+			require.NoError(t, err)
+			require.Equal(t, i, v)
+		}
+	})
+}
+
+func TestJSONUint64(t *testing.T) {
+	callJSONUint64 := func(input string, fn func(uint64, error)) {
+		fn(parseint.JSONUint64(input))
+		fn(parseint.JSONUint64([]byte(input)))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range map[string]uint64{
+			"0":                    0,
+			"1":                    1,
+			"1234567890":           1234567890,
+			"18446744073709551615": math.MaxUint64,
+		} {
+			callJSONUint64(input, func(actual uint64, err error) {
+				require.NoError(t, err, "%q", input)
+				require.Equal(t, expect, actual, "%q", input)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range map[string]error{
+			"":                     parseint.ErrSyntax,
+			"-0":                   parseint.ErrSyntax,
+			"-1":                   parseint.ErrSyntax,
+			"+1":                   parseint.ErrSyntax,
+			"01":                   parseint.ErrSyntax,
+			"1.":                   parseint.ErrSyntax,
+			"1x":                   parseint.ErrSyntax,
+			"0.5":                  parseint.ErrNotInteger,
+			"1e3":                  parseint.ErrNotInteger,
+			"18446744073709551616": parseint.ErrOverflow,
+		} {
+			callJSONUint64(input, func(a uint64, err error) {
+				require.ErrorIs(t, err, expectedErr, "%q", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+}
+
+func FuzzJSONInt64(f *testing.F) {
+	for input := range validJSONInt64 {
+		f.Add(input)
+	}
+	for input := range invalidJSONInt64 {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		x, err := parseint.JSONInt64(s, false)
+		isNumber := jsonNumber.MatchString(s)
+		isInteger := isNumber && !strings.ContainsAny(s, ".eE")
+		std, errStd := strconv.ParseInt(s, 10, 64)
+		switch {
+		case err == nil:
+			if !isInteger || errStd != nil {
+				t.Fatalf("must have returned error but didn't: %q", s)
+			} else if std != x {
+				t.Errorf("expected %d; received: %d", std, x)
+			}
+		case x != 0:
+			t.Errorf("%q: failed but returned non-zero value: %x", s, x)
+		case err == parseint.ErrNotInteger:
+			if !isNumber || isInteger {
+				t.Fatalf("unexpected error for input %q: %v", s, err)
+			}
+		case err == parseint.ErrOverflow:
+			if !isInteger || errStd == nil {
+				t.Fatalf("unexpected error for input %q: %v", s, err)
+			}
+		case isNumber:
+			t.Fatalf("unexpected error for input %q: %v", s, err)
+		}
+	})
+}
+
+func BenchmarkJSONInt64(b *testing.B) {
+	fn := getBenchmarkFn(b, func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 64)
+	}, func(s string) (int64, error) {
+		return parseint.JSONInt64(s, false)
+	})
+	fnBytes := getBenchmarkFn(b, func(s []byte) (int64, error) {
+		return strconv.ParseInt(string(s), 10, 64)
+	}, func(s []byte) (int64, error) {
+		return parseint.JSONInt64(s, false)
+	})
+
+	var a int64
+	var err error
+	for _, td := range []struct {
+		name  string
+		input string
+	}{
+		{"min", "-9223372036854775808"},
+		{"zero", "0"},
+		{"small_3", "987"},
+		{"max", "9223372036854775807"},
+		{"syntax", "0123"},
+		{"overflow", "9223372036854775808"},
+	} {
+		b.Run(td.name+"/string", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				a, err = fn(td.input)
+			}
+		})
+		inputBytes := []byte(td.input)
+		b.Run(td.name+"/bytes", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				a, err = fnBytes(inputBytes)
+			}
+		})
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
@@ -0,0 +1,145 @@
+package parseint
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// LineError records the line number of the line that failed to parse.
+type LineError struct {
+	Line int   // 1-based line number.
+	Err  error // ErrSyntax or ErrOverflow.
+}
+
+func (e *LineError) Error() string {
+	return "line " + strconv.Itoa(e.Line) + ": " + e.Err.Error()
+}
+
+func (e *LineError) Unwrap() error { return e.Err }
+
+// linesMinChunk is the minimum number of bytes per goroutine
+// below which parsing concurrently isn't worth it.
+const linesMinChunk = 64 << 10
+
+// Base10Int64Lines parses s consisting of one base-10 signed 64-bit integer
+// per line and returns the values in input order. Lines are terminated by
+// "\n" or "\r\n", the terminator of the last line is optional.
+// s is split at line boundaries into chunks that are parsed concurrently
+// by up to workers goroutines. If workers <= 0, runtime.GOMAXPROCS(0) is used.
+// Returns *LineError if any line fails to parse, which is always the first
+// failing line regardless of the number of workers.
+func Base10Int64Lines[S string | []byte](s S, workers int) ([]int64, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if n := len(s) / linesMinChunk; workers > n {
+		workers = max(n, 1)
+	}
+
+	// Split s into chunks of roughly equal size at line boundaries.
+	chunks := make([]S, 0, workers)
+	for rest := s; len(rest) > 0; {
+		end := len(s) / workers
+		if end >= len(rest) || len(chunks) == workers-1 {
+			chunks = append(chunks, rest)
+			break
+		}
+		for end < len(rest) && rest[end-1] != '\n' {
+			end++
+		}
+		chunks = append(chunks, rest[:end])
+		rest = rest[end:]
+	}
+
+	// Count the lines of each chunk to determine where its values go.
+	first := make([]int, len(chunks)+1) // first[i] is the index of chunk i's first line.
+	forEachChunk(len(chunks), func(i int) {
+		first[i+1] = countLines(chunks[i])
+	})
+	for i := range chunks {
+		first[i+1] += first[i]
+	}
+
+	// Parse the chunks into their part of the result.
+	values := make([]int64, first[len(chunks)])
+	errs := make([]error, len(chunks))
+	var failed atomic.Int64 // Index of the first failed chunk.
+	failed.Store(int64(len(chunks)))
+	forEachChunk(len(chunks), func(i int) {
+		v := values[first[i]:first[i+1]]
+		for l, c := 0, chunks[i]; len(c) > 0; l++ {
+			if l%1024 == 0 && failed.Load() < int64(i) {
+				return // A preceding chunk failed already.
+			}
+			end := 0
+			for end < len(c) && c[end] != '\n' {
+				end++
+			}
+			line := c[:end]
+			if end < len(c) {
+				c = c[end+1:]
+			} else {
+				c = c[end:]
+			}
+			if len(line) > 0 && line[len(line)-1] == '\r' {
+				line = line[:len(line)-1]
+			}
+			x, err := Base10Int64(line)
+			if err != nil {
+				errs[i] = &LineError{Line: first[i] + l + 1, Err: err}
+				for f := failed.Load(); int64(i) < f; f = failed.Load() {
+					if failed.CompareAndSwap(f, int64(i)) {
+						break
+					}
+				}
+				return
+			}
+			v[l] = x
+		}
+	})
+	if f := failed.Load(); f < int64(len(chunks)) {
+		return nil, errs[f]
+	}
+	return values, nil
+}
+
+// forEachChunk calls fn for each chunk index in [0, n) concurrently
+// and waits for all calls to return.
+func forEachChunk(n int, fn func(i int)) {
+	if n == 1 {
+		fn(0)
+		return
+	}
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			fn(i)
+		}()
+	}
+	wg.Wait()
+}
+
+// countLines returns the number of lines in s
+// including the last line without a terminator.
+func countLines[S string | []byte](s S) int {
+	if len(s) == 0 {
+		return 0
+	}
+	var n int
+	switch s := any(s).(type) {
+	case string:
+		n = strings.Count(s, "\n")
+	case []byte:
+		n = bytes.Count(s, []byte{'\n'})
+	}
+	if s[len(s)-1] != '\n' {
+		n++
+	}
+	return n
+}
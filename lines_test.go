@@ -0,0 +1,140 @@
+package parseint_test
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase10Int64Lines(t *testing.T) {
+	for input, expect := range map[string][]int64{
+		"":           {},
+		"0":          {0},
+		"0\n":        {0},
+		"1\n-2\n+3":  {1, -2, 3},
+		"1\r\n2\r\n": {1, 2},
+		"9223372036854775807\n-9223372036854775808\n": {
+			math.MaxInt64, math.MinInt64,
+		},
+	} {
+		actual, err := parseint.Base10Int64Lines(input, 0)
+		require.NoError(t, err, "%q", input)
+		require.Equal(t, expect, actual, "%q", input)
+		actual, err = parseint.Base10Int64Lines([]byte(input), 4)
+		require.NoError(t, err, "%q", input)
+		require.Equal(t, expect, actual, "%q", input)
+	}
+
+	for input, expect := range map[string]parseint.LineError{
+		"\n":                        {Line: 1, Err: parseint.ErrSyntax},
+		"1\n\n2":                    {Line: 2, Err: parseint.ErrSyntax},
+		"1\n2\n\n":                  {Line: 3, Err: parseint.ErrSyntax},
+		"1\n2 \n3":                  {Line: 2, Err: parseint.ErrSyntax},
+		"1\n2\r\r\n3":               {Line: 2, Err: parseint.ErrSyntax},
+		"1\n2\n9223372036854775808": {Line: 3, Err: parseint.ErrOverflow},
+	} {
+		actual, err := parseint.Base10Int64Lines(input, 0)
+		var le *parseint.LineError
+		require.True(t, errors.As(err, &le), "%q", input)
+		require.Equal(t, expect, *le, "%q", input)
+		require.ErrorIs(t, err, expect.Err, "%q", input)
+		require.Nil(t, actual, "%q", input)
+	}
+}
+
+func TestBase10Int64LinesParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	expect := make([]int64, 500_000)
+	var b strings.Builder
+	for i := range expect {
+		expect[i] = r.Int63() >> r.Intn(63)
+		if r.Intn(2) == 0 {
+			expect[i] = -expect[i]
+		}
+		b.WriteString(strconv.FormatInt(expect[i], 10))
+		if r.Intn(2) == 0 {
+			b.WriteByte('\r')
+		}
+		b.WriteByte('\n')
+	}
+	input := b.String()
+
+	for _, workers := range []int{0, 1, 2, 3, 7, 16, 1000} {
+		actual, err := parseint.Base10Int64Lines(input, workers)
+		require.NoError(t, err, "workers: %d", workers)
+		require.Equal(t, expect, actual, "workers: %d", workers)
+	}
+
+	t.Run("first_error", func(t *testing.T) {
+		lines := strings.SplitAfter(input, "\n")
+		// Break ever earlier lines such that the failing lines spread across
+		// multiple chunks. The first one must always be reported.
+		for _, l := range []int{len(lines) - 2, 400_000, len(lines) / 2, 123_456} {
+			lines[l] = "x\n"
+			in := []byte(strings.Join(lines, ""))
+			for _, workers := range []int{1, 2, 3, 7, 16} {
+				actual, err := parseint.Base10Int64Lines(in, workers)
+				var le *parseint.LineError
+				require.True(t, errors.As(err, &le), "workers: %d", workers)
+				require.Equal(t, l+1, le.Line, "workers: %d", workers)
+				require.ErrorIs(t, err, parseint.ErrSyntax)
+				require.Nil(t, actual)
+			}
+		}
+	})
+}
+
+func BenchmarkBase10Int64Lines(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	var sb strings.Builder
+	for i := 0; i < 1_000_000; i++ {
+		sb.WriteString(strconv.FormatInt(r.Int63()>>r.Intn(63), 10))
+		sb.WriteByte('\n')
+	}
+	input := []byte(sb.String())
+
+	var a []int64
+	var err error
+	workerCounts := []int{1}
+	if p := runtime.GOMAXPROCS(0); p > 1 {
+		workerCounts = append(workerCounts, p)
+	}
+	for _, workers := range workerCounts {
+		b.Run("workers_"+strconv.Itoa(workers), func(b *testing.B) {
+			// The strconv baseline is single-threaded regardless of workers.
+			fn := getBenchmarkImpl(b, func(s []byte) ([]int64, error) {
+				var a []int64
+				for len(s) > 0 {
+					line := s
+					if i := bytes.IndexByte(s, '\n'); i >= 0 {
+						line, s = s[:i], s[i+1:]
+					} else {
+						s = nil
+					}
+					v, err := strconv.ParseInt(string(line), 10, 64)
+					if err != nil {
+						return nil, err
+					}
+					a = append(a, v)
+				}
+				return a, nil
+			}, func(s []byte) ([]int64, error) {
+				return parseint.Base10Int64Lines(s, workers)
+			})
+			b.SetBytes(int64(len(input)))
+			for n := 0; n < b.N; n++ {
+				a, err = fn(input)
+			}
+		})
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
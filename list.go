@@ -0,0 +1,111 @@
+package parseint
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ErrTooManyItems is returned by AppendList if the list contains
+// more than ListOptions.MaxItems items.
+var ErrTooManyItems = errors.New("too many items")
+
+// ListOptions configures AppendList.
+type ListOptions struct {
+	// Sep separates the items. Defaults to ',' if zero.
+	Sep byte
+
+	// TrimSpace allows ASCII whitespace around the items.
+	TrimSpace bool
+
+	// SkipEmpty skips empty items, for example in "1,,2" or "1,2,",
+	// instead of passing them to the parser, which rejects them.
+	SkipEmpty bool
+
+	// MaxItems limits the number of parsed items if greater than zero.
+	MaxItems int
+}
+
+// ListError records the index of the list item that failed to parse.
+type ListError struct {
+	Index int   // Index of the item including skipped empty items.
+	Err   error // Error returned by the parser or ErrTooManyItems.
+}
+
+func (e *ListError) Error() string {
+	return "item " + strconv.Itoa(e.Index) + ": " + e.Err.Error()
+}
+
+func (e *ListError) Unwrap() error { return e.Err }
+
+// AppendList parses the separated list of items in s, for example "4,8,15",
+// using parse, which can be any of the parse functions of this package,
+// and appends the values to dst. An empty s is an empty list.
+// Returns *ListError if any item fails to parse or if there are more than
+// opts.MaxItems items, in which case dst is returned without
+// any of the items appended.
+func AppendList[S string | []byte, T any](
+	dst []T, s S, opts ListOptions, parse func(S) (T, error),
+) ([]T, error) {
+	l := len(dst)
+	t := newListTokenizer(s, opts)
+	for {
+		item, index, ok := t.next()
+		if !ok {
+			return dst, nil
+		}
+		if opts.MaxItems > 0 && len(dst)-l >= opts.MaxItems {
+			return dst[:l], &ListError{Index: index, Err: ErrTooManyItems}
+		}
+		v, err := parse(item)
+		if err != nil {
+			return dst[:l], &ListError{Index: index, Err: err}
+		}
+		dst = append(dst, v)
+	}
+}
+
+// listTokenizer splits a separated list into items according to ListOptions.
+type listTokenizer[S string | []byte] struct {
+	s     S
+	sep   byte
+	opts  ListOptions
+	index int
+	done  bool
+}
+
+func newListTokenizer[S string | []byte](s S, opts ListOptions) listTokenizer[S] {
+	t := listTokenizer[S]{s: s, sep: opts.Sep, opts: opts}
+	if t.sep == 0 {
+		t.sep = ','
+	}
+	if len(s) == 0 || (opts.TrimSpace && len(trimSpace(s)) == 0) {
+		t.done = true // Empty list.
+	}
+	return t
+}
+
+// next returns the next item and its index.
+// Returns ok=false once all items are consumed.
+func (t *listTokenizer[S]) next() (item S, index int, ok bool) {
+	for !t.done {
+		i := 0
+		for i < len(t.s) && t.s[i] != t.sep {
+			i++
+		}
+		item, index = t.s[:i], t.index
+		if i < len(t.s) {
+			t.s = t.s[i+1:]
+		} else {
+			t.done = true
+		}
+		t.index++
+		if t.opts.TrimSpace {
+			item = trimSpace(item)
+		}
+		if len(item) == 0 && t.opts.SkipEmpty {
+			continue
+		}
+		return item, index, true
+	}
+	return item, 0, false
+}
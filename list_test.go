@@ -0,0 +1,177 @@
+package parseint_test
+
+import (
+	"errors"
+	"math"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendList(t *testing.T) {
+	type listInput struct {
+		Input string
+		Opts  parseint.ListOptions
+	}
+	trim := parseint.ListOptions{TrimSpace: true}
+	skip := parseint.ListOptions{SkipEmpty: true}
+	space := parseint.ListOptions{Sep: ' ', SkipEmpty: true}
+
+	for input, expect := range map[listInput][]int64{
+		{"", parseint.ListOptions{}}:                nil,
+		{"  ", trim}:                                nil,
+		{"4,8,15,16,23,42", parseint.ListOptions{}}: {4, 8, 15, 16, 23, 42},
+		{"-1,+2,0", parseint.ListOptions{}}:         {-1, 2, 0},
+		{" 1 ,\t2\n, 3 ", trim}:                     {1, 2, 3},
+		{"1,,2,", skip}:                             {1, 2},
+		{",", skip}:                                 nil,
+		{"1, ,2", parseint.ListOptions{TrimSpace: true, SkipEmpty: true}}: {1, 2},
+		{"10 20  30", space}:                         {10, 20, 30},
+		{"1;2;3", parseint.ListOptions{Sep: ';'}}:    {1, 2, 3},
+		{"1,2,3", parseint.ListOptions{MaxItems: 3}}: {1, 2, 3},
+		{"9223372036854775807,-9223372036854775808", parseint.ListOptions{}}: {
+			math.MaxInt64, math.MinInt64,
+		},
+	} {
+		actual, err := parseint.AppendList(nil, input.Input, input.Opts,
+			parseint.Base10Int64[string])
+		require.NoError(t, err, "%#v", input)
+		require.Equal(t, expect, actual, "%#v", input)
+
+		actual, err = parseint.AppendList(nil, []byte(input.Input), input.Opts,
+			parseint.Base10Int64[[]byte])
+		require.NoError(t, err, "%#v", input)
+		require.Equal(t, expect, actual, "%#v", input)
+	}
+
+	for input, expect := range map[listInput]parseint.ListError{
+		{",", parseint.ListOptions{}}:     {Index: 0, Err: parseint.ErrSyntax},
+		{"1,", parseint.ListOptions{}}:    {Index: 1, Err: parseint.ErrSyntax},
+		{"1,,2", parseint.ListOptions{}}:  {Index: 1, Err: parseint.ErrSyntax},
+		{"1, 2", parseint.ListOptions{}}:  {Index: 1, Err: parseint.ErrSyntax},
+		{"1,,x", skip}:                    {Index: 2, Err: parseint.ErrSyntax},
+		{"1, ,2", trim}:                   {Index: 1, Err: parseint.ErrSyntax},
+		{"1,2,9223372036854775808", trim}: {Index: 2, Err: parseint.ErrOverflow},
+		{"1 2", parseint.ListOptions{}}:   {Index: 0, Err: parseint.ErrSyntax},
+		{"1,2,3,4", parseint.ListOptions{MaxItems: 3}}: {
+			Index: 3, Err: parseint.ErrTooManyItems,
+		},
+		{"1,,2,,3,,4", parseint.ListOptions{SkipEmpty: true, MaxItems: 3}}: {
+			Index: 6, Err: parseint.ErrTooManyItems,
+		},
+	} {
+		dst := []int64{7}
+		actual, err := parseint.AppendList(dst, input.Input, input.Opts,
+			parseint.Base10Int64[string])
+		var le *parseint.ListError
+		require.True(t, errors.As(err, &le), "%#v", input)
+		require.Equal(t, expect, *le, "%#v", input)
+		require.ErrorIs(t, err, expect.Err, "%#v", input)
+		require.Equal(t, []int64{7}, actual, "%#v", input)
+	}
+}
+
+func TestAppendListTypes(t *testing.T) {
+	u16, err := parseint.AppendList(nil, "ff,0,FFFF", parseint.ListOptions{},
+		parseint.Base16Uint16[string, uint16])
+	require.NoError(t, err)
+	require.Equal(t, []uint16{0xff, 0, 0xffff}, u16)
+
+	u32, err := parseint.AppendList([]uint32{1}, []byte("2 3"),
+		parseint.ListOptions{Sep: ' '}, parseint.Base10Uint32[[]byte, uint32])
+	require.NoError(t, err)
+	require.Equal(t, []uint32{1, 2, 3}, u32)
+
+	_, err = parseint.AppendList(nil, "1,-1", parseint.ListOptions{},
+		parseint.Base10Uint64[string])
+	require.ErrorIs(t, err, parseint.ErrSyntax)
+	require.Equal(t, "item 1: syntax error", err.Error())
+}
+
+func TestAppendListAllocs(t *testing.T) {
+	dst := make([]int64, 0, 16)
+	allocs := testing.AllocsPerRun(100, func() {
+		var err error
+		dst, err = parseint.AppendList(dst[:0], "4, 8, 15, 16, 23, 42",
+			parseint.ListOptions{TrimSpace: true}, parseint.Base10Int64[string])
+		if err != nil {
+			panic(err)
+		}
+	})
+	require.Zero(t, allocs)
+}
+
+func FuzzAppendList(f *testing.F) {
+	for _, s := range []string{
+		"", "1", "1,2,3", "1,,2", ",", " 1 , 2 ", "9223372036854775808,1", "x",
+	} {
+		f.Add(s, true, true)
+	}
+
+	f.Fuzz(func(t *testing.T, s string, trimSpace, skipEmpty bool) {
+		opts := parseint.ListOptions{TrimSpace: trimSpace, SkipEmpty: skipEmpty}
+		actual, err := parseint.AppendList(nil, s, opts, parseint.Base10Int64[string])
+
+		// Reference implementation using strings.Split and strconv.
+		var expect []int64
+		var expectErr *parseint.ListError
+		if s != "" && (!trimSpace || strings.TrimLeft(s, "\t\n\v\f\r ") != "") {
+			for i, item := range strings.Split(s, ",") {
+				if trimSpace {
+					item = strings.Trim(item, "\t\n\v\f\r ")
+				}
+				if item == "" && skipEmpty {
+					continue
+				}
+				v, errStd := strconv.ParseInt(item, 10, 64)
+				if errStd != nil {
+					expectErr = &parseint.ListError{Index: i}
+					expect = nil
+					break
+				}
+				expect = append(expect, v)
+			}
+		}
+
+		if expectErr != nil {
+			var le *parseint.ListError
+			if !errors.As(err, &le) || le.Index != expectErr.Index {
+				t.Fatalf("%q: expected error at %d; received: %v", s, expectErr.Index, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", s, err)
+		}
+		require.Equal(t, expect, actual, "%q", s)
+	})
+}
+
+func BenchmarkAppendList(b *testing.B) {
+	const input = "4,8,15,16,23,42,108,4815,162342"
+	var a []int64
+	var err error
+
+	fn := getBenchmarkImpl(b, func(dst []int64, s string) ([]int64, error) {
+		for _, item := range strings.Split(s, ",") {
+			v, err := strconv.ParseInt(item, 10, 64)
+			if err != nil {
+				return dst, err
+			}
+			dst = append(dst, v)
+		}
+		return dst, nil
+	}, func(dst []int64, s string) ([]int64, error) {
+		return parseint.AppendList(dst, s, parseint.ListOptions{},
+			parseint.Base10Int64[string])
+	})
+	for n := 0; n < b.N; n++ {
+		a, err = fn(a[:0], input)
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
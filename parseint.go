@@ -210,6 +210,88 @@ func init() {
 	lutHex['F'] = 15
 }
 
+// underscores defines where parseDigits accepts underscores.
+type underscores uint8
+
+const (
+	underscoresNone     underscores = iota // No underscores.
+	underscoresAnywhere                    // Any number of underscores anywhere.
+	underscoresBetween                     // Single underscores between two digits.
+)
+
+// parseDigits parses s as a non-empty sequence of digits in the given base
+// (2, 8, 10 or 16) and underscores accepted by the given policy.
+// Returns ErrSyntax if s contains an invalid character or a misplaced underscore.
+// Returns ErrOverflow if the value exceeds max.
+func parseDigits[S string | []byte](
+	s S, base, max uint64, policy underscores,
+) (uint64, error) {
+	if len(s) == 0 || (policy == underscoresBetween && s[len(s)-1] == '_') {
+		return 0, ErrSyntax
+	}
+	cutoff, cutlim := max/base, max%base
+	var n uint64
+	overflow := false  // Keep validating the syntax after an overflow.
+	underscore := true // Preceded by an underscore or at the beginning.
+	for _, c := range []byte(s) {
+		if c == '_' {
+			switch policy {
+			case underscoresNone:
+				return 0, ErrSyntax
+			case underscoresBetween:
+				if underscore { // Leading or double underscore.
+					return 0, ErrSyntax
+				}
+				underscore = true
+			}
+			continue
+		}
+		underscore = false
+		d := uint64(lutHex[c])
+		if d >= base {
+			return 0, ErrSyntax
+		}
+		if n > cutoff || (n == cutoff && d > cutlim) {
+			overflow = true
+		}
+		n = n*base + d
+	}
+	if overflow {
+		return 0, ErrOverflow
+	}
+	return n, nil
+}
+
+// base16Uint64 parses s as a base-16 (hexadecimal) unsigned 64-bit integer
+// by combining the results of Base16Uint32 for the upper and lower half.
+// Returns ErrSyntax if s contains an invalid character.
+// Returns ErrOverflow if the stringified value overflows a uint64.
+func base16Uint64[S string | []byte](s S) (uint64, error) {
+	for len(s) > 1 && s[0] == '0' { // Skip all leading zeroes if any.
+		s = s[1:]
+	}
+	switch {
+	case len(s) <= 8:
+		return Base16Uint32[S, uint64](s)
+	case len(s) > 16:
+		for _, c := range []byte(s) {
+			if lutHex[c] == invalidHexByte {
+				return 0, ErrSyntax
+			}
+		}
+		return 0, ErrOverflow
+	}
+	hi, err := Base16Uint32[S, uint64](s[:len(s)-8])
+	if err != nil {
+		return 0, err
+	}
+	lo, err := Base16Uint32[S, uint64](s[len(s)-8:])
+	if err != nil {
+		return 0, err
+	}
+	return hi<<32 | lo, nil
+}
+
 // Base10Uint32 parses s as a base-10 unsigned 32-bit integer.
 // Returns ErrSyntax if s contains an invalid character.
 // Returns ErrOverflow if the stringified value overflows a uint32.
@@ -33,3 +33,32 @@ func getBenchmarkFn[I any, S []byte | string](b *testing.B,
 	}
 	return nil
 }
+
+func getAppendBenchmarkFn[I any](b *testing.B,
+	strconvImpl func(dst []byte, i I) []byte, parseintImpl func(dst []byte, i I) []byte,
+) func([]byte, I) []byte {
+	switch *fBenchmarkFn {
+	case BenchmarkFnStrconv:
+		return strconvImpl
+	case BenchmarkFnParseint:
+		return parseintImpl
+	default:
+		b.Fatalf("unknown benchmark function: %q", *fBenchmarkFn)
+	}
+	return nil
+}
+
+// getBenchmarkImpl is like getBenchmarkFn but for benchmarks comparing
+// implementations of any signature, such as whole-input processing loops.
+func getBenchmarkImpl[F any](b *testing.B, strconvImpl, parseintImpl F) F {
+	switch *fBenchmarkFn {
+	case BenchmarkFnStrconv:
+		return strconvImpl
+	case BenchmarkFnParseint:
+		return parseintImpl
+	default:
+		b.Fatalf("unknown benchmark function: %q", *fBenchmarkFn)
+	}
+	var zero F
+	return zero
+}
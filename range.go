@@ -0,0 +1,110 @@
+package parseint
+
+// ErrOutOfRange is matched by ErrBelowMin and ErrAboveMax, which are returned
+// by the range-bounded parse functions if the parsed value is outside
+// of the inclusive bounds.
+// errors.Is(ErrOutOfRange, ErrOverflow) reports true.
+var ErrOutOfRange error = outOfRangeError{}
+
+var (
+	// ErrBelowMin is returned together with min by the range-bounded
+	// parse functions if the parsed value is lower than min.
+	// It matches both ErrOutOfRange and ErrOverflow.
+	ErrBelowMin error = outOfRangeError{msg: "out of range: below minimum"}
+
+	// ErrAboveMax is returned together with max by the range-bounded
+	// parse functions if the parsed value is greater than max.
+	// It matches both ErrOutOfRange and ErrOverflow.
+	ErrAboveMax error = outOfRangeError{msg: "out of range: above maximum"}
+)
+
+type outOfRangeError struct{ msg string }
+
+func (e outOfRangeError) Error() string {
+	if e.msg == "" {
+		return "out of range"
+	}
+	return e.msg
+}
+
+// Is makes ErrBelowMin and ErrAboveMax match ErrOutOfRange,
+// and all three match ErrOverflow.
+func (outOfRangeError) Is(target error) bool {
+	return target == ErrOutOfRange || target == ErrOverflow
+}
+
+// Base16Uint16Range is similar to Base16Uint16 but returns min and
+// ErrBelowMin if the value is lower than min, and max and ErrAboveMax
+// if it's greater than max, so the violated bound is known without allocating.
+func Base16Uint16Range[S string | []byte, U ~uint64 | ~uint32 | ~uint16](
+	s S, min, max U,
+) (U, error) {
+	v, err := Base16Uint16[S, U](s)
+	return inRange(v, err, min, max)
+}
+
+// Base16Uint32Range is similar to Base16Uint32 but returns min and
+// ErrBelowMin if the value is lower than min, and max and ErrAboveMax
+// if it's greater than max, so the violated bound is known without allocating.
+func Base16Uint32Range[S string | []byte, U ~uint64 | ~uint32](
+	s S, min, max U,
+) (U, error) {
+	v, err := Base16Uint32[S, U](s)
+	return inRange(v, err, min, max)
+}
+
+// Base10Uint32Range is similar to Base10Uint32 but returns min and
+// ErrBelowMin if the value is lower than min, and max and ErrAboveMax
+// if it's greater than max, so the violated bound is known without allocating.
+// ErrOverflow is returned if the value overflows a uint32.
+func Base10Uint32Range[S string | []byte, U ~uint64 | ~uint32](
+	s S, min, max U,
+) (U, error) {
+	v, err := Base10Uint32[S, U](s)
+	return inRange(v, err, min, max)
+}
+
+// Base10Int32Range is similar to Base10Int32 but returns min and
+// ErrBelowMin if the value is lower than min, and max and ErrAboveMax
+// if it's greater than max, so the violated bound is known without allocating.
+// ErrOverflow is returned if the value overflows an int32.
+func Base10Int32Range[S string | []byte, I ~int64 | ~int32](
+	s S, min, max I,
+) (I, error) {
+	v, err := Base10Int32[S, I](s)
+	return inRange(v, err, min, max)
+}
+
+// Base10Uint64Range is similar to Base10Uint64 but returns min and
+// ErrBelowMin if the value is lower than min, and max and ErrAboveMax
+// if it's greater than max, so the violated bound is known without allocating.
+// ErrOverflow is returned if the value overflows a uint64.
+func Base10Uint64Range[S string | []byte](s S, min, max uint64) (uint64, error) {
+	v, err := Base10Uint64(s)
+	return inRange(v, err, min, max)
+}
+
+// Base10Int64Range is similar to Base10Int64 but returns min and
+// ErrBelowMin if the value is lower than min, and max and ErrAboveMax
+// if it's greater than max, so the violated bound is known without allocating.
+// ErrOverflow is returned if the value overflows an int64.
+func Base10Int64Range[S string | []byte](s S, min, max int64) (int64, error) {
+	v, err := Base10Int64(s)
+	return inRange(v, err, min, max)
+}
+
+// inRange returns the violated bound and ErrBelowMin or ErrAboveMax
+// if v isn't within min and max, otherwise returns v and err unchanged.
+func inRange[T ~int64 | ~int32 | ~uint64 | ~uint32 | ~uint16](
+	v T, err error, min, max T,
+) (T, error) {
+	switch {
+	case err != nil:
+		return v, err
+	case v < min:
+		return min, ErrBelowMin
+	case v > max:
+		return max, ErrAboveMax
+	}
+	return v, nil
+}
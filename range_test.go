@@ -0,0 +1,157 @@
+package parseint_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrOutOfRange(t *testing.T) {
+	require.True(t, errors.Is(parseint.ErrOutOfRange, parseint.ErrOverflow))
+	require.True(t, errors.Is(parseint.ErrOutOfRange, parseint.ErrOutOfRange))
+	require.False(t, errors.Is(parseint.ErrOverflow, parseint.ErrOutOfRange))
+	require.False(t, errors.Is(parseint.ErrOutOfRange, parseint.ErrSyntax))
+
+	for _, err := range []error{parseint.ErrBelowMin, parseint.ErrAboveMax} {
+		require.True(t, errors.Is(err, parseint.ErrOutOfRange))
+		require.True(t, errors.Is(err, parseint.ErrOverflow))
+		require.False(t, errors.Is(err, parseint.ErrSyntax))
+	}
+	require.False(t, errors.Is(parseint.ErrBelowMin, parseint.ErrAboveMax))
+	require.False(t, errors.Is(parseint.ErrAboveMax, parseint.ErrBelowMin))
+	require.False(t, errors.Is(parseint.ErrOutOfRange, parseint.ErrBelowMin))
+}
+
+func TestBase10Int64Range(t *testing.T) {
+	for _, td := range []struct {
+		input       string
+		min, max    int64
+		expect      int64
+		expectedErr error
+	}{
+		{"0", 0, 100, 0, nil},
+		{"100", 0, 100, 100, nil},
+		{"50", 0, 100, 50, nil},
+		{"-1", 0, 100, 0, parseint.ErrBelowMin},
+		{"101", 0, 100, 100, parseint.ErrAboveMax},
+		{"-5", -10, -5, -5, nil},
+		{"-11", -10, -5, -10, parseint.ErrBelowMin},
+		{"9223372036854775807", math.MinInt64, math.MaxInt64, math.MaxInt64, nil},
+		{"9223372036854775808", 0, 100, 0, parseint.ErrOverflow},
+		{"x", 0, 100, 0, parseint.ErrSyntax},
+		{"", 0, 100, 0, parseint.ErrSyntax},
+	} {
+		for _, r := range []struct {
+			v   int64
+			err error
+		}{
+			call2(parseint.Base10Int64Range(td.input, td.min, td.max)),
+			call2(parseint.Base10Int64Range([]byte(td.input), td.min, td.max)),
+		} {
+			if td.expectedErr == nil {
+				require.NoError(t, r.err, "%q", td.input)
+			} else {
+				require.ErrorIs(t, r.err, td.expectedErr, "%q", td.input)
+			}
+			require.Equal(t, td.expect, r.v, "%q", td.input)
+		}
+	}
+}
+
+func TestBase10Uint64Range(t *testing.T) {
+	v, err := parseint.Base10Uint64Range("65535", 1, 65535)
+	require.NoError(t, err)
+	require.Equal(t, uint64(65535), v)
+
+	v, err = parseint.Base10Uint64Range("0", 1, 65535)
+	require.ErrorIs(t, err, parseint.ErrOutOfRange)
+	require.ErrorIs(t, err, parseint.ErrOverflow)
+	require.Equal(t, parseint.ErrBelowMin, err)
+	require.Equal(t, "out of range: below minimum", err.Error())
+	require.Equal(t, uint64(1), v)
+
+	v, err = parseint.Base10Uint64Range([]byte("65536"), 1, 65535)
+	require.ErrorIs(t, err, parseint.ErrOutOfRange)
+	require.Equal(t, parseint.ErrAboveMax, err)
+	require.Equal(t, uint64(65535), v)
+
+	v, err = parseint.Base10Uint64Range("18446744073709551616", 1, 65535)
+	require.ErrorIs(t, err, parseint.ErrOverflow)
+	require.NotErrorIs(t, err, parseint.ErrOutOfRange)
+	require.Zero(t, v)
+}
+
+func TestBase10Int32Range(t *testing.T) {
+	v, err := parseint.Base10Int32Range[string, int32]("-40", -40, 125)
+	require.NoError(t, err)
+	require.Equal(t, int32(-40), v)
+
+	v, err = parseint.Base10Int32Range[string, int32]("-41", -40, 125)
+	require.Equal(t, parseint.ErrBelowMin, err)
+	require.Equal(t, int32(-40), v)
+
+	v64, err := parseint.Base10Int32Range[[]byte, int64]([]byte("126"), -40, 125)
+	require.Equal(t, parseint.ErrAboveMax, err)
+	require.Equal(t, int64(125), v64)
+
+	_, err = parseint.Base10Int32Range[string, int64]("2147483648", -40, 125)
+	require.ErrorIs(t, err, parseint.ErrOverflow)
+	require.NotErrorIs(t, err, parseint.ErrOutOfRange)
+}
+
+func TestBase10Uint32Range(t *testing.T) {
+	v, err := parseint.Base10Uint32Range[string, uint32]("100", 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint32(100), v)
+
+	v, err = parseint.Base10Uint32Range[string, uint32]("101", 0, 100)
+	require.ErrorIs(t, err, parseint.ErrOutOfRange)
+	require.Equal(t, uint32(100), v)
+
+	_, err = parseint.Base10Uint32Range[string, uint32]("1x", 0, 100)
+	require.ErrorIs(t, err, parseint.ErrSyntax)
+}
+
+func TestBase16Range(t *testing.T) {
+	v16, err := parseint.Base16Uint16Range[string, uint16]("ff", 0x10, 0xff)
+	require.NoError(t, err)
+	require.Equal(t, uint16(0xff), v16)
+
+	v16, err = parseint.Base16Uint16Range[string, uint16]("f", 0x10, 0xff)
+	require.Equal(t, parseint.ErrBelowMin, err)
+	require.Equal(t, uint16(0x10), v16)
+
+	v32, err := parseint.Base16Uint32Range[[]byte, uint32]([]byte("10000"), 0, 0xffff)
+	require.Equal(t, parseint.ErrAboveMax, err)
+	require.Equal(t, uint32(0xffff), v32)
+
+	_, err = parseint.Base16Uint32Range[string, uint32]("xyz", 0, 0xffff)
+	require.ErrorIs(t, err, parseint.ErrSyntax)
+}
+
+func TestRangeAlloc(t *testing.T) {
+	input := []byte("65535")
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = parseint.Base10Uint64Range(input, 1, 65535)
+	})
+	require.Zero(t, allocs)
+
+	input = []byte("65536")
+	allocs = testing.AllocsPerRun(100, func() {
+		_, _ = parseint.Base10Uint64Range(input, 1, 65535)
+	})
+	require.Zero(t, allocs)
+}
+
+func call2[T any](v T, err error) struct {
+	v   T
+	err error
+} {
+	return struct {
+		v   T
+		err error
+	}{v, err}
+}
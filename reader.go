@@ -0,0 +1,168 @@
+package parseint
+
+import (
+	"bufio"
+	"io"
+)
+
+const (
+	defaultReaderSize = 4096
+	minReaderSize     = 32
+	maxEmptyReads     = 100
+)
+
+// Reader reads separator-delimited integers from an io.Reader.
+// It reads into its own buffer and parses the integers directly from it
+// without allocating, including integers that are split across reads.
+// By default, ASCII whitespace separates integers, see SetSeparators.
+type Reader struct {
+	rd         io.Reader
+	buf        []byte
+	start, end int   // buf[start:end] is buffered but not yet consumed.
+	err        error // Sticky error returned by rd.
+	sep        [256]bool
+}
+
+// NewReader returns a new Reader reading from rd
+// with a buffer of the default size.
+func NewReader(rd io.Reader) *Reader {
+	return NewReaderSize(rd, defaultReaderSize)
+}
+
+// NewReaderSize returns a new Reader reading from rd with a buffer of
+// at least size bytes. The buffer size limits the length of a single integer
+// including its leading zeroes.
+func NewReaderSize(rd io.Reader, size int) *Reader {
+	if size < minReaderSize {
+		size = minReaderSize
+	}
+	return &Reader{rd: rd, buf: make([]byte, size), sep: asciiSpace}
+}
+
+// Reset discards any buffered data and the sticky error, and makes r read
+// from rd. The buffer and the separators are retained.
+func (r *Reader) Reset(rd io.Reader) {
+	r.rd, r.start, r.end, r.err = rd, 0, 0, nil
+}
+
+// SetSeparators sets the bytes separating integers, for example " ,\n".
+// Consecutive separators are treated as one.
+func (r *Reader) SetSeparators(separators string) {
+	r.sep = [256]bool{}
+	for i := 0; i < len(separators); i++ {
+		r.sep[separators[i]] = true
+	}
+}
+
+// NextInt64 reads the next integer and parses it with Base10Int64.
+// See Reader.Next for the error semantics.
+func (r *Reader) NextInt64() (int64, error) {
+	tok, err := r.Next()
+	if err != nil {
+		return 0, err
+	}
+	return Base10Int64(tok)
+}
+
+// NextUint64 reads the next integer and parses it with Base10Uint64.
+// See Reader.Next for the error semantics.
+func (r *Reader) NextUint64() (uint64, error) {
+	tok, err := r.Next()
+	if err != nil {
+		return 0, err
+	}
+	return Base10Uint64(tok)
+}
+
+// NextHex reads the next integer and parses it as a base-16 (hexadecimal)
+// unsigned 64-bit integer without prefix. Leading zeroes are skipped.
+// See Reader.Next for the error semantics.
+func (r *Reader) NextHex() (uint64, error) {
+	tok, err := r.Next()
+	if err != nil {
+		return 0, err
+	}
+	return base16Uint64(tok)
+}
+
+// Next skips any separators and returns the next token, which is valid only
+// until the next call to r. Returns io.EOF once all tokens are consumed,
+// or the error returned by the underlying reader. A token cut off by an error
+// other than io.EOF may be incomplete and is discarded instead of returned.
+// Returns bufio.ErrTooLong if the token doesn't fit into the buffer,
+// in which case the token is skipped.
+func (r *Reader) Next() ([]byte, error) {
+	for { // Skip separators.
+		for r.start < r.end && r.sep[r.buf[r.start]] {
+			r.start++
+		}
+		if r.start < r.end {
+			break
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+		r.fill()
+	}
+
+	i := r.start
+	for {
+		for i < r.end && !r.sep[r.buf[i]] {
+			i++
+		}
+		if i < r.end || r.err == io.EOF { // Found the end of the token.
+			tok := r.buf[r.start:i]
+			r.start = i
+			return tok, nil
+		}
+		if r.err != nil { // The token was cut off by a read error.
+			r.start = r.end
+			return nil, r.err
+		}
+		if r.start == 0 && r.end == len(r.buf) {
+			r.skipToken()
+			return nil, bufio.ErrTooLong
+		}
+		// The token continues past the buffered data.
+		n := i - r.start
+		r.fill()
+		i = r.start + n
+	}
+}
+
+// skipToken discards the current token including the parts not yet read.
+func (r *Reader) skipToken() {
+	for {
+		for r.start < r.end && !r.sep[r.buf[r.start]] {
+			r.start++
+		}
+		if r.start < r.end || r.err != nil {
+			return
+		}
+		r.fill()
+	}
+}
+
+// fill moves the unconsumed data to the beginning of the buffer and
+// reads a new chunk into the free rest of the buffer.
+func (r *Reader) fill() {
+	if r.start > 0 {
+		r.end = copy(r.buf, r.buf[r.start:r.end])
+		r.start = 0
+	}
+	for i := 0; i < maxEmptyReads; i++ {
+		n, err := r.rd.Read(r.buf[r.end:])
+		if n < 0 {
+			panic("parseint: reader returned negative count from Read")
+		}
+		r.end += n
+		if err != nil {
+			r.err = err
+			return
+		}
+		if n > 0 {
+			return
+		}
+	}
+	r.err = io.ErrNoProgress
+}
@@ -0,0 +1,233 @@
+package parseint_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader(t *testing.T) {
+	r := parseint.NewReader(strings.NewReader(
+		"  1 -2\t+3\n\n9223372036854775807  -9223372036854775808 x 9223372036854775808 42",
+	))
+	for _, expect := range []struct {
+		v   int64
+		err error
+	}{
+		{1, nil},
+		{-2, nil},
+		{3, nil},
+		{math.MaxInt64, nil},
+		{math.MinInt64, nil},
+		{0, parseint.ErrSyntax},
+		{0, parseint.ErrOverflow},
+		{42, nil},
+		{0, io.EOF},
+		{0, io.EOF},
+	} {
+		v, err := r.NextInt64()
+		require.ErrorIs(t, err, expect.err)
+		require.Equal(t, expect.v, v)
+	}
+}
+
+func TestReaderUint64(t *testing.T) {
+	r := parseint.NewReader(strings.NewReader("0 18446744073709551615 -1"))
+	v, err := r.NextUint64()
+	require.NoError(t, err)
+	require.Zero(t, v)
+	v, err = r.NextUint64()
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), v)
+	v, err = r.NextUint64()
+	require.ErrorIs(t, err, parseint.ErrSyntax)
+	require.Zero(t, v)
+	_, err = r.NextUint64()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestReaderHex(t *testing.T) {
+	r := parseint.NewReader(strings.NewReader(
+		"ff,DEADBEEF,,0000ffffffffffffffff,10000000000000000,0x1\n",
+	))
+	r.SetSeparators(",\n")
+	for _, expect := range []struct {
+		v   uint64
+		err error
+	}{
+		{0xff, nil},
+		{0xdeadbeef, nil},
+		{math.MaxUint64, nil},
+		{0, parseint.ErrOverflow},
+		{0, parseint.ErrSyntax},
+		{0, io.EOF},
+	} {
+		v, err := r.NextHex()
+		require.ErrorIs(t, err, expect.err)
+		require.Equal(t, expect.v, v)
+	}
+}
+
+func TestReaderTooLong(t *testing.T) {
+	long := strings.Repeat("0", 100) + "1"
+	r := parseint.NewReaderSize(strings.NewReader("1 "+long+" 2 "+long), 16)
+	v, err := r.NextInt64()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), v)
+	_, err = r.NextInt64()
+	require.ErrorIs(t, err, bufio.ErrTooLong)
+	v, err = r.NextInt64()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), v)
+	_, err = r.NextInt64()
+	require.ErrorIs(t, err, bufio.ErrTooLong)
+	_, err = r.NextInt64()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestReaderError(t *testing.T) {
+	errTest := errors.New("test error")
+	r := parseint.NewReader(io.MultiReader(
+		strings.NewReader("1 2 "), iotest.ErrReader(errTest),
+	))
+	v, err := r.NextInt64()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), v)
+	v, err = r.NextInt64()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), v)
+	_, err = r.NextInt64()
+	require.ErrorIs(t, err, errTest)
+	_, err = r.NextInt64()
+	require.ErrorIs(t, err, errTest, "the error must be sticky")
+
+	r.Reset(strings.NewReader("3"))
+	v, err = r.NextInt64()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), v)
+	_, err = r.NextInt64()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestReaderErrorMidToken(t *testing.T) {
+	errTest := errors.New("test error")
+	r := parseint.NewReader(io.MultiReader(
+		strings.NewReader("1 56"), iotest.ErrReader(errTest),
+	))
+	v, err := r.NextInt64()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), v)
+	v, err = r.NextInt64()
+	require.ErrorIs(t, err, errTest, "the cut off token must be discarded")
+	require.Zero(t, v)
+	_, err = r.NextInt64()
+	require.ErrorIs(t, err, errTest, "the error must be sticky")
+}
+
+func TestReaderEmptyReads(t *testing.T) {
+	r := parseint.NewReader(emptyReader{})
+	_, err := r.NextInt64()
+	require.ErrorIs(t, err, io.ErrNoProgress)
+}
+
+type emptyReader struct{}
+
+func (emptyReader) Read([]byte) (int, error) { return 0, nil }
+
+func TestReaderRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	expect := make([]int64, 10_000)
+	var b strings.Builder
+	for i := range expect {
+		expect[i] = rnd.Int63() >> rnd.Intn(63)
+		if rnd.Intn(2) == 0 {
+			expect[i] = -expect[i]
+		}
+		b.WriteString(strconv.FormatInt(expect[i], 10))
+		b.WriteString([]string{" ", "\n", "\t\t", "\r\n"}[rnd.Intn(4)])
+	}
+	input := b.String()
+
+	for _, wrap := range []struct {
+		name string
+		fn   func(io.Reader) io.Reader
+	}{
+		{"plain", func(r io.Reader) io.Reader { return r }},
+		{"one_byte", iotest.OneByteReader},
+		{"half", iotest.HalfReader},
+		{"data_err", iotest.DataErrReader},
+	} {
+		for _, size := range []int{21, 22, 31, 32, 64, 4096} {
+			r := parseint.NewReaderSize(wrap.fn(strings.NewReader(input)), size)
+			for i, e := range expect {
+				v, err := r.NextInt64()
+				require.NoError(t, err, "%s %d: %d", wrap.name, size, i)
+				require.Equal(t, e, v, "%s %d: %d", wrap.name, size, i)
+			}
+			_, err := r.NextInt64()
+			require.ErrorIs(t, err, io.EOF, "%s %d", wrap.name, size)
+		}
+	}
+}
+
+func TestReaderAllocs(t *testing.T) {
+	input := strings.Repeat("12345 -67890\n", 1000)
+	sr := strings.NewReader(input)
+	r := parseint.NewReaderSize(sr, 64)
+	allocs := testing.AllocsPerRun(10, func() {
+		sr.Reset(input)
+		r.Reset(sr)
+		for {
+			if _, err := r.NextInt64(); err == io.EOF {
+				break
+			}
+		}
+	})
+	require.Zero(t, allocs)
+}
+
+func BenchmarkReader(b *testing.B) {
+	var sb strings.Builder
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 10_000; i++ {
+		sb.WriteString(strconv.FormatInt(rnd.Int63()>>rnd.Intn(63), 10))
+		sb.WriteByte('\n')
+	}
+	input := sb.String()
+
+	r := parseint.NewReader(nil)
+	fn := getBenchmarkImpl(b, func(input string) error {
+		s := bufio.NewScanner(strings.NewReader(input))
+		s.Split(bufio.ScanWords)
+		for s.Scan() {
+			if _, err := strconv.ParseInt(s.Text(), 10, 64); err != nil {
+				return err
+			}
+		}
+		return s.Err()
+	}, func(input string) error {
+		r.Reset(strings.NewReader(input))
+		for {
+			_, err := r.NextInt64()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+	})
+	for n := 0; n < b.N; n++ {
+		if err := fn(input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
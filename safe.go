@@ -0,0 +1,39 @@
+package parseint
+
+const (
+	// MaxSafeInteger is the largest integer that an IEEE-754 double-precision
+	// float can represent exactly (JavaScript's Number.MAX_SAFE_INTEGER).
+	MaxSafeInteger = 1<<53 - 1
+
+	// MinSafeInteger is the smallest integer that an IEEE-754 double-precision
+	// float can represent exactly (JavaScript's Number.MIN_SAFE_INTEGER).
+	MinSafeInteger = -MaxSafeInteger
+)
+
+// Base10SafeInt64 is similar to Base10Int64 but returns ErrOverflow
+// if the value is outside of [MinSafeInteger, MaxSafeInteger]
+// and would thus lose precision as a JavaScript number.
+func Base10SafeInt64[S string | []byte](s S) (int64, error) {
+	v, err := Base10Int64(s)
+	if err != nil {
+		return 0, err
+	}
+	if v > MaxSafeInteger || v < MinSafeInteger {
+		return 0, ErrOverflow
+	}
+	return v, nil
+}
+
+// Base10SafeUint64 is similar to Base10Uint64 but returns ErrOverflow
+// if the value is greater than MaxSafeInteger
+// and would thus lose precision as a JavaScript number.
+func Base10SafeUint64[S string | []byte](s S) (uint64, error) {
+	v, err := Base10Uint64(s)
+	if err != nil {
+		return 0, err
+	}
+	if v > MaxSafeInteger {
+		return 0, ErrOverflow
+	}
+	return v, nil
+}
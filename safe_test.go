@@ -0,0 +1,128 @@
+package parseint_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+var validBase10SafeInt64 = map[string]int64{
+	"0":                   0,
+	"-0":                  0,
+	"+1":                  1,
+	"-1":                  -1,
+	"9007199254740991":    9007199254740991,
+	"-9007199254740991":   -9007199254740991,
+	"+9007199254740991":   9007199254740991,
+	"0009007199254740991": 9007199254740991,
+	"9007199254740990":    9007199254740990,
+}
+
+var invalidBase10SafeInt64 = map[string]error{
+	"":                     parseint.ErrSyntax,
+	"-":                    parseint.ErrSyntax,
+	"1.0":                  parseint.ErrSyntax,
+	"1e3":                  parseint.ErrSyntax,
+	"900719925474099x":     parseint.ErrSyntax,
+	"9007199254740992":     parseint.ErrOverflow,
+	"-9007199254740992":    parseint.ErrOverflow,
+	"9223372036854775807":  parseint.ErrOverflow,
+	"-9223372036854775808": parseint.ErrOverflow,
+	"9223372036854775808":  parseint.ErrOverflow,
+	"99999999999999999999": parseint.ErrOverflow,
+}
+
+func TestBase10SafeInt64(t *testing.T) {
+	require.Equal(t, int64(9007199254740991), int64(parseint.MaxSafeInteger))
+	require.Equal(t, float64(parseint.MaxSafeInteger),
+		float64(parseint.MaxSafeInteger-1)+1)
+	require.Equal(t, float64(parseint.MaxSafeInteger+1),
+		float64(parseint.MaxSafeInteger+2), "2^53+1 isn't representable")
+
+	callBase10SafeInt64 := func(input string, fn func(int64, error)) {
+		fn(parseint.Base10SafeInt64(input))
+		fn(parseint.Base10SafeInt64([]byte(input)))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range validBase10SafeInt64 {
+			callBase10SafeInt64(input, func(actual int64, err error) {
+				require.NoError(t, err, "%q", input)
+				require.Equal(t, expect, actual, "%q", input)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range invalidBase10SafeInt64 {
+			callBase10SafeInt64(input, func(a int64, err error) {
+				require.ErrorIs(t, err, expectedErr, "%q", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+}
+
+func TestBase10SafeUint64(t *testing.T) {
+	for input, expect := range map[string]uint64{
+		"0":                   0,
+		"1":                   1,
+		"9007199254740991":    9007199254740991,
+		"0009007199254740991": 9007199254740991,
+	} {
+		actual, err := parseint.Base10SafeUint64(input)
+		require.NoError(t, err, "%q", input)
+		require.Equal(t, expect, actual, "%q", input)
+		actual, err = parseint.Base10SafeUint64([]byte(input))
+		require.NoError(t, err, "%q", input)
+		require.Equal(t, expect, actual, "%q", input)
+	}
+
+	for input, expectedErr := range map[string]error{
+		"":                     parseint.ErrSyntax,
+		"-1":                   parseint.ErrSyntax,
+		"+1":                   parseint.ErrSyntax,
+		"9007199254740992":     parseint.ErrOverflow,
+		"18446744073709551615": parseint.ErrOverflow,
+		"18446744073709551616": parseint.ErrOverflow,
+	} {
+		actual, err := parseint.Base10SafeUint64(input)
+		require.ErrorIs(t, err, expectedErr, "%q", input)
+		require.Zero(t, actual)
+	}
+}
+
+func FuzzBase10SafeInt64(f *testing.F) {
+	for input := range validBase10SafeInt64 {
+		f.Add(input)
+	}
+	for input := range invalidBase10SafeInt64 {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		x, err := parseint.Base10SafeInt64(s)
+		std, errStd := strconv.ParseInt(s, 10, 64)
+		if errStd == nil && (std > parseint.MaxSafeInteger ||
+			std < parseint.MinSafeInteger) {
+			errStd = strconv.ErrRange
+		}
+		switch {
+		case errStd == nil:
+			if err != nil || x != std {
+				t.Fatalf("%q: expected %d; received: %d, %v", s, std, x, err)
+			}
+			// The value must round-trip through float64 exactly.
+			if f := float64(x); int64(f) != x || math.Abs(f) > parseint.MaxSafeInteger {
+				t.Fatalf("%q: not a safe integer: %d", s, x)
+			}
+		case x != 0:
+			t.Errorf("%q: failed but returned non-zero value: %d", s, x)
+		case err == nil:
+			t.Fatalf("must have returned error but didn't: %q", s)
+		}
+	})
+}
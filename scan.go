@@ -0,0 +1,22 @@
+package parseint
+
+// ScanInts is a bufio.SplitFunc that splits the input into tokens separated
+// by ASCII whitespace for parsing with any of the Base10 or Base16 functions.
+// Unlike bufio.ScanWords it doesn't decode UTF-8 and doesn't treat
+// Unicode whitespace as a separator, which is cheaper for numeric input.
+// ScanInts never returns an error; the tokens are validated when parsed.
+func ScanInts(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	start := 0
+	for start < len(data) && asciiSpace[data[start]] {
+		start++
+	}
+	for i := start; i < len(data); i++ {
+		if asciiSpace[data[i]] {
+			return i + 1, data[start:i], nil
+		}
+	}
+	if atEOF && len(data) > start {
+		return len(data), data[start:], nil
+	}
+	return start, nil, nil // Request more data.
+}
@@ -0,0 +1,38 @@
+package parseint_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanInts(t *testing.T) {
+	// U+00A0 (no-break space) isn't ASCII whitespace and remains part of the token.
+	const input = "  1 -2\t\t+3\n\n9223372036854775807 x \u00a042\v"
+	for _, reader := range []struct {
+		name string
+		new  func() *bufio.Scanner
+	}{
+		{"plain", func() *bufio.Scanner {
+			return bufio.NewScanner(strings.NewReader(input))
+		}},
+		{"one_byte", func() *bufio.Scanner {
+			return bufio.NewScanner(iotest.OneByteReader(strings.NewReader(input)))
+		}},
+	} {
+		s := reader.new()
+		s.Split(parseint.ScanInts)
+		var tokens []string
+		for s.Scan() {
+			tokens = append(tokens, s.Text())
+		}
+		require.NoError(t, s.Err(), reader.name)
+		require.Equal(t, []string{
+			"1", "-2", "+3", "9223372036854775807", "x", "\u00a042",
+		}, tokens, reader.name)
+	}
+}
@@ -0,0 +1,128 @@
+package parseint
+
+// Base10Int64Sci parses s as a base-10 signed 64-bit integer in scientific
+// notation with an optional '-' or '+' sign, an optional fraction and
+// an optional exponent introduced by 'e' or 'E', for example "1e6", "1.5E3",
+// "2.50e2" or "-1.5e+3". Plain integers such as "42" are accepted as well.
+// At least one digit is required before and after the decimal point '.'
+// and after the exponent character and its optional sign.
+// The value is computed exactly without going through float64.
+// Returns ErrSyntax if s contains an invalid character or if the value
+// is not integral, such as "1.5" or "15e-1".
+// Returns ErrOverflow if the value overflows an int64.
+// Zero is zero regardless of the exponent, for example "0e999999999999999999".
+func Base10Int64Sci[S string | []byte](s S) (int64, error) {
+	if len(s) == 0 {
+		return 0, ErrSyntax
+	}
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	// Split s into the integer part, the fraction and the exponent.
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	intDigits := s[:i]
+	if len(intDigits) == 0 {
+		return 0, ErrSyntax
+	}
+	var fracDigits S
+	if i < len(s) && s[i] == '.' {
+		i++
+		start := i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == start { // No fractional digits after the decimal point.
+			return 0, ErrSyntax
+		}
+		fracDigits = s[start:i]
+	}
+	var exp int64
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		expNeg := false
+		if i < len(s) && (s[i] == '-' || s[i] == '+') {
+			expNeg = s[i] == '-'
+			i++
+		}
+		start := i
+		for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+			if exp < sciMaxExp { // Saturate, the value is far out of range anyway.
+				exp = exp*10 + int64(s[i]-'0')
+			}
+		}
+		if i == start { // No exponent digits.
+			return 0, ErrSyntax
+		}
+		if expNeg {
+			exp = -exp
+		}
+	}
+	if i != len(s) {
+		return 0, ErrSyntax
+	}
+
+	// The mantissa digits are intDigits followed by fracDigits.
+	digit := func(k int) byte {
+		if k < len(intDigits) {
+			return intDigits[k]
+		}
+		return fracDigits[k-len(intDigits)]
+	}
+	total := len(intDigits) + len(fracDigits)
+	first := 0 // First non-zero mantissa digit.
+	for first < total && digit(first) == '0' {
+		first++
+	}
+	if first == total {
+		return 0, nil
+	}
+	last := total - 1 // Last non-zero mantissa digit.
+	for digit(last) == '0' {
+		last--
+	}
+
+	// The value is the significant digits times 10^exp.
+	exp += int64(total-1-last) - int64(len(fracDigits))
+	if exp < 0 {
+		return 0, ErrSyntax // Not integral.
+	}
+	if int64(last-first+1)+exp > 19 {
+		return 0, ErrOverflow
+	}
+	max := uint64(1<<63 - 1)
+	if neg {
+		max = 1 << 63
+	}
+	var n uint64 // Can't overflow a uint64 with at most 19 digits.
+	for k := first; k <= last; k++ {
+		n = n*10 + uint64(digit(k)-'0')
+	}
+	if n > max {
+		return 0, ErrOverflow
+	}
+	for ; exp > 0; exp-- {
+		if n > max/10 {
+			return 0, ErrOverflow
+		}
+		n *= 10
+	}
+
+	if neg {
+		return int64(-n), nil
+	}
+	return int64(n), nil
+}
+
+// sciMaxExp is the exponent at which Base10Int64Sci stops accumulating
+// exponent digits. It is large enough to exceed any mantissa length
+// that could bring the value back into range.
+const sciMaxExp = 1 << 48
@@ -0,0 +1,208 @@
+package parseint_test
+
+import (
+	"math"
+	"math/big"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+var validBase10Int64Sci = map[string]int64{
+	"0":                             0,
+	"-0":                            0,
+	"+0":                            0,
+	"42":                            42,
+	"-42":                           -42,
+	"1e6":                           1_000_000,
+	"1E6":                           1_000_000,
+	"1e+6":                          1_000_000,
+	"1.5E3":                         1500,
+	"2.50e2":                        250,
+	"-1.5e3":                        -1500,
+	"100e-2":                        1,
+	"1000e-3":                       1,
+	"1.0":                           1,
+	"1.000e0":                       1,
+	"0.001e3":                       1,
+	"00012e1":                       120,
+	"1e0":                           1,
+	"0e0":                           0,
+	"0e-5":                          0,
+	"0.0e999999999999999999999999":  0,
+	"-0.000e-999999999999999999999": 0,
+	"9.223372036854775807e18":       math.MaxInt64,
+	"-9.223372036854775808e18":      math.MinInt64,
+	"9223372036854775807":           math.MaxInt64,
+	"-9223372036854775808":          math.MinInt64,
+	"92233720368547758070e-1":       math.MaxInt64,
+	"9223372036854775807.000":       math.MaxInt64,
+	"1e18":                          1_000_000_000_000_000_000,
+	"-9e18":                         -9_000_000_000_000_000_000,
+	"123456789012345678900000e-5":   1_234_567_890_123_456_789,
+}
+
+var invalidBase10Int64Sci = map[string]error{
+	"":                         parseint.ErrSyntax,
+	"-":                        parseint.ErrSyntax,
+	"e5":                       parseint.ErrSyntax,
+	".5e1":                     parseint.ErrSyntax,
+	"1.e3":                     parseint.ErrSyntax,
+	"1e":                       parseint.ErrSyntax,
+	"1e+":                      parseint.ErrSyntax,
+	"1e--3":                    parseint.ErrSyntax,
+	"1e3.0":                    parseint.ErrSyntax,
+	"1.5":                      parseint.ErrSyntax,
+	"150e-2":                   parseint.ErrSyntax,
+	"15e-1":                    parseint.ErrSyntax,
+	"1.25e1":                   parseint.ErrSyntax,
+	"1e-999999999999999999999": parseint.ErrSyntax,
+	"1x":                       parseint.ErrSyntax,
+	" 1e3":                     parseint.ErrSyntax,
+	"1e3 ":                     parseint.ErrSyntax,
+	"1_000e3":                  parseint.ErrSyntax,
+	"inf":                      parseint.ErrSyntax,
+	"NaN":                      parseint.ErrSyntax,
+	"0x1p3":                    parseint.ErrSyntax,
+	"--1e3":                    parseint.ErrSyntax,
+
+	"1e19":                     parseint.ErrOverflow,
+	"1e21":                     parseint.ErrOverflow,
+	"9.223372036854775808e18":  parseint.ErrOverflow,
+	"-9.223372036854775809e18": parseint.ErrOverflow,
+	"9223372036854775808":      parseint.ErrOverflow,
+	"1e999999999999999999999":  parseint.ErrOverflow,
+	"12345678901234567890e0":   parseint.ErrOverflow,
+}
+
+func TestBase10Int64Sci(t *testing.T) {
+	callBase10Int64Sci := func(input string, fn func(int64, error)) {
+		fn(parseint.Base10Int64Sci(input))
+		fn(parseint.Base10Int64Sci([]byte(input)))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range validBase10Int64Sci {
+			callBase10Int64Sci(input, func(actual int64, err error) {
+				require.NoError(t, err, "%q", input)
+				require.Equal(t, expect, actual, "%q", input)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range invalidBase10Int64Sci {
+			callBase10Int64Sci(input, func(a int64, err error) {
+				require.ErrorIs(t, err, expectedErr, "%q", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+
+	t.Run("range_10k", func(t *testing.T) {
+		for i := int64(-10_000); i <= 10_000; i++ {
+			for _, input := range []string{
+				strconv.FormatInt(i, 10),
+				strconv.FormatFloat(float64(i), 'e', -1, 64),
+				strconv.FormatFloat(float64(i), 'E', 10, 64),
+			} {
+				callBase10Int64Sci(input, func(actual int64, err error) {
+					require.NoError(t, err, "%q", input)
+					require.Equal(t, i, actual, "%q", input)
+				})
+			}
+		}
+	})
+}
+
+var sciSyntax = regexp.MustCompile(`^[-+]?[0-9]+(\.[0-9]+)?([eE][-+]?([0-9]+))?$`)
+
+func FuzzBase10Int64Sci(f *testing.F) {
+	for input := range validBase10Int64Sci {
+		f.Add(input)
+	}
+	for input := range invalidBase10Int64Sci {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		x, err := parseint.Base10Int64Sci(s)
+		m := sciSyntax.FindStringSubmatch(s)
+		if m == nil {
+			if err != parseint.ErrSyntax {
+				t.Fatalf("%q: expected ErrSyntax; received: %d, %v", s, x, err)
+			}
+			return
+		}
+		if len(strings.TrimLeft(m[3], "0")) > 5 {
+			// Avoid computing huge powers of ten with big.Rat.
+			mantissa := strings.TrimLeft(s[:strings.IndexAny(s, "eE")], "+-")
+			var expectErr error
+			switch {
+			case strings.Trim(mantissa, "0.") == "":
+				expectErr = nil
+			case strings.ContainsRune(s[strings.IndexAny(s, "eE"):], '-'):
+				expectErr = parseint.ErrSyntax
+			default:
+				expectErr = parseint.ErrOverflow
+			}
+			if err != expectErr || x != 0 {
+				t.Fatalf("%q: expected 0, %v; received: %d, %v", s, expectErr, x, err)
+			}
+			return
+		}
+		r, ok := new(big.Rat).SetString(s)
+		if !ok {
+			t.Fatalf("%q: big.Rat rejected input", s)
+		}
+		switch {
+		case !r.IsInt():
+			if err != parseint.ErrSyntax {
+				t.Fatalf("%q: expected ErrSyntax; received: %d, %v", s, x, err)
+			}
+		case !r.Num().IsInt64():
+			if err != parseint.ErrOverflow {
+				t.Fatalf("%q: expected ErrOverflow; received: %d, %v", s, x, err)
+			}
+		case err != nil || x != r.Num().Int64():
+			t.Fatalf("%q: expected %s; received: %d, %v", s, r.Num(), x, err)
+		}
+	})
+}
+
+func BenchmarkBase10Int64Sci(b *testing.B) {
+	fn := getBenchmarkFn(b, func(s string) (int64, error) {
+		f, err := strconv.ParseFloat(s, 64)
+		if err == nil && (f != math.Trunc(f) || math.Abs(f) >= 1<<63) {
+			return 0, strconv.ErrRange
+		}
+		return int64(f), err
+	}, parseint.Base10Int64Sci[string])
+
+	var a int64
+	var err error
+	for _, td := range []struct {
+		name  string
+		input string
+	}{
+		{"int", "123456789"},
+		{"exp", "1e6"},
+		{"frac_exp", "-1.2345e4"},
+		{"large", "9.223372036854775807e18"},
+		{"non_integral", "1.5"},
+		{"overflow", "1e21"},
+	} {
+		b.Run(td.name+"/string", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				a, err = fn(td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
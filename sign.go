@@ -0,0 +1,85 @@
+package parseint
+
+import "unicode/utf8"
+
+// Base10Int32LenientSign is similar to Base10Int32 but additionally accepts
+// the following multi-byte UTF-8 sign variants in place of '-' and '+':
+//
+//	U+2212 MINUS SIGN (−)
+//	U+FE63 SMALL HYPHEN-MINUS (﹣)
+//	U+FF0D FULLWIDTH HYPHEN-MINUS (－)
+//	U+FE62 SMALL PLUS SIGN (﹢)
+//	U+FF0B FULLWIDTH PLUS SIGN (＋)
+//
+// Inputs starting with an ASCII character are passed to Base10Int32 directly.
+func Base10Int32LenientSign[S string | []byte, I ~int64 | ~int32](s S) (I, error) {
+	if len(s) == 0 || s[0] < utf8.RuneSelf {
+		return Base10Int32[S, I](s)
+	}
+	neg, size := lenientSign(s)
+	if size == 0 {
+		return 0, ErrSyntax
+	}
+	n, err := Base10Uint32[S, uint64](s[size:])
+	if err != nil {
+		return 0, err
+	}
+	if neg {
+		if n > 1<<31 {
+			return 0, ErrOverflow
+		}
+		return I(-int64(n)), nil
+	}
+	if n > 1<<31-1 {
+		return 0, ErrOverflow
+	}
+	return I(n), nil
+}
+
+// Base10Int64LenientSign is similar to Base10Int64 but additionally accepts
+// the multi-byte UTF-8 sign variants listed in Base10Int32LenientSign.
+// Inputs starting with an ASCII character are passed to Base10Int64 directly.
+func Base10Int64LenientSign[S string | []byte](s S) (int64, error) {
+	if len(s) == 0 || s[0] < utf8.RuneSelf {
+		return Base10Int64(s)
+	}
+	neg, size := lenientSign(s)
+	if size == 0 {
+		return 0, ErrSyntax
+	}
+	n, err := Base10Uint64(s[size:])
+	if err != nil {
+		return 0, err
+	}
+	if neg {
+		if n > 1<<63 {
+			return 0, ErrOverflow
+		}
+		return int64(-n), nil
+	}
+	if n > 1<<63-1 {
+		return 0, ErrOverflow
+	}
+	return int64(n), nil
+}
+
+// lenientSign returns the byte size of the multi-byte UTF-8 sign at
+// the beginning of s and whether it's a minus, or size=0 if there is none.
+func lenientSign[S string | []byte](s S) (neg bool, size int) {
+	if len(s) < 3 {
+		return false, 0
+	}
+	switch c0, c1, c2 := s[0], s[1], s[2]; {
+	case c0 == 0xe2 && c1 == 0x88 && c2 == 0x92: // U+2212
+		return true, 3
+	case c0 == 0xef && c1 == 0xb9 && c2 == 0xa3: // U+FE63
+		return true, 3
+	case c0 == 0xef && c1 == 0xbc && c2 == 0x8d: // U+FF0D
+		return true, 3
+	case c0 == 0xef && c1 == 0xb9 && c2 == 0xa2: // U+FE62
+		return false, 3
+	case c0 == 0xef && c1 == 0xbc && c2 == 0x8b: // U+FF0B
+		return false, 3
+	}
+	return false, 0
+}
@@ -0,0 +1,180 @@
+package parseint_test
+
+import (
+	"math"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+var validLenientSign = map[string]int64{
+	"42":                     42,
+	"-42":                    -42,
+	"+42":                    42,
+	"−42":                    -42,
+	"﹣42":                    -42,
+	"－42":                    -42,
+	"﹢42":                    42,
+	"＋42":                    42,
+	"−0":                     0,
+	"−2147483648":            math.MinInt32,
+	"＋2147483647":            math.MaxInt32,
+	"−9223372036854775808":   math.MinInt64,
+	"＋9223372036854775807":   math.MaxInt64,
+	"−000000000000000000001": -1,
+}
+
+var invalidLenientSign = map[string]error{
+	"":                      parseint.ErrSyntax,
+	"−":                     parseint.ErrSyntax,
+	"＋":                     parseint.ErrSyntax,
+	"−-42":                  parseint.ErrSyntax,
+	"−+42":                  parseint.ErrSyntax,
+	"-−42":                  parseint.ErrSyntax,
+	"−−42":                  parseint.ErrSyntax,
+	"–42":                   parseint.ErrSyntax, // EN DASH isn't a sign.
+	"− 42":                  parseint.ErrSyntax,
+	"\xe2\x88":              parseint.ErrSyntax,
+	"\xe242":                parseint.ErrSyntax,
+	"ж":                     parseint.ErrSyntax,
+	"42−":                   parseint.ErrSyntax,
+	"−9223372036854775809":  parseint.ErrOverflow,
+	"＋9223372036854775808":  parseint.ErrOverflow,
+	"−99999999999999999999": parseint.ErrOverflow,
+}
+
+func TestBase10Int64LenientSign(t *testing.T) {
+	callLenientSign := func(input string, fn func(int64, error)) {
+		fn(parseint.Base10Int64LenientSign(input))
+		fn(parseint.Base10Int64LenientSign([]byte(input)))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range validLenientSign {
+			callLenientSign(input, func(actual int64, err error) {
+				require.NoError(t, err, "%q", input)
+				require.Equal(t, expect, actual, "%q", input)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range invalidLenientSign {
+			callLenientSign(input, func(a int64, err error) {
+				require.ErrorIs(t, err, expectedErr, "%q", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+}
+
+func TestBase10Int32LenientSign(t *testing.T) {
+	callLenientSign := func(input string, fn func(any, error)) {
+		fn(parseint.Base10Int32LenientSign[string, int32](input))
+		fn(parseint.Base10Int32LenientSign[string, int64](input))
+		fn(parseint.Base10Int32LenientSign[[]byte, int32]([]byte(input)))
+		fn(parseint.Base10Int32LenientSign[[]byte, int64]([]byte(input)))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range validLenientSign {
+			if expect < math.MinInt32 || expect > math.MaxInt32 {
+				continue
+			}
+			callLenientSign(input, func(actual any, err error) {
+				require.NoError(t, err, "%q", input)
+				switch actual := actual.(type) {
+				case int64:
+					require.Equal(t, expect, actual, "%q", input)
+				case int32:
+					require.Equal(t, int32(expect), actual, "%q", input)
+				default:
+					t.Fatalf("unexpected type: %T", actual)
+				}
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range map[string]error{
+			"−":                    parseint.ErrSyntax,
+			"−-1":                  parseint.ErrSyntax,
+			"−x":                   parseint.ErrSyntax,
+			"−2147483649":          parseint.ErrOverflow,
+			"＋2147483648":          parseint.ErrOverflow,
+			"－9223372036854775808": parseint.ErrOverflow,
+		} {
+			callLenientSign(input, func(a any, err error) {
+				require.ErrorIs(t, err, expectedErr, "%q", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+}
+
+func FuzzBase10Int64LenientSign(f *testing.F) {
+	for input := range validLenientSign {
+		f.Add(input)
+	}
+	for input := range invalidLenientSign {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		x, err := parseint.Base10Int64LenientSign(s)
+		ascii := s
+		for _, v := range []struct{ variant, ascii string }{
+			{"−", "-"}, {"﹣", "-"}, {"－", "-"},
+			{"﹢", "+"}, {"＋", "+"},
+		} {
+			if len(s) >= len(v.variant) && s[:len(v.variant)] == v.variant {
+				ascii = v.ascii + s[len(v.variant):]
+				break
+			}
+		}
+		std, errStd := strconv.ParseInt(ascii, 10, 64)
+		if err == nil {
+			if errStd != nil {
+				t.Fatalf("must have returned error %v but didn't: %q", errStd, s)
+			} else if std != x {
+				t.Errorf("expected %d; received: %d", std, x)
+			}
+		} else {
+			if x != 0 {
+				t.Errorf("%q: failed but returned non-zero value: %x", s, x)
+			}
+			if errStd == nil {
+				t.Fatalf("unexpected error for input %q: %v", s, err)
+			}
+		}
+	})
+}
+
+func BenchmarkBase10Int64LenientSign(b *testing.B) {
+	fn := getBenchmarkFn(b, func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 64)
+	}, parseint.Base10Int64LenientSign[string])
+
+	var a int64
+	var err error
+	for _, td := range []struct {
+		name  string
+		input string
+	}{
+		{"ascii_neg", "-9876543210123"},
+		{"ascii_pos", "9876543210123"},
+		{"unicode_neg", "−9876543210123"},
+		{"syntax", "−"},
+	} {
+		b.Run(td.name+"/string", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				a, err = fn(td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
@@ -0,0 +1,131 @@
+package parseint
+
+import "math/bits"
+
+// Strtol parses the longest prefix of s that forms an integer in the given base
+// following the semantics of C's strtol with a 64-bit long:
+//
+//   - Leading whitespace (' ', '\t', '\n', '\v', '\f', '\r') is skipped.
+//   - An optional '+' or '-' sign is accepted.
+//   - base must be 0 or between 2 and 36. Letters 'a'-'z' and 'A'-'Z'
+//     represent the digits 10 to 35.
+//   - If base is 16 an optional "0x" or "0X" prefix is accepted.
+//   - If base is 0 the base is detected from the prefix:
+//     "0x" or "0X" is base 16, "0" is base 8, anything else is base 10.
+//   - Parsing stops at the first character that isn't a valid digit.
+//
+// end is the offset in s right after the last parsed digit, which corresponds
+// to C's endptr. A "0x" prefix that isn't followed by a hex digit is parsed
+// as the single digit "0", so end points at the 'x'.
+//
+// Returns ErrSyntax and end 0 if no digits could be parsed or if base is invalid.
+// Returns ErrOverflow alongside math.MaxInt64 or math.MinInt64 (C's ERANGE)
+// if the value is out of range, in which case end still points right after
+// the last digit.
+func Strtol[S string | []byte](s S, base int) (v int64, end int, err error) {
+	u, neg, end, err := strtoul(s, base)
+	switch {
+	case err == ErrSyntax:
+		return 0, 0, err
+	case neg:
+		if err != nil || u > 1<<63 {
+			return -1 << 63, end, ErrOverflow
+		}
+		return int64(-u), end, nil
+	case err != nil || u > 1<<63-1:
+		return 1<<63 - 1, end, ErrOverflow
+	}
+	return int64(u), end, nil
+}
+
+// Strtoul is similar to Strtol but follows the semantics of C's strtoul with
+// a 64-bit unsigned long. A negative value is negated in unsigned arithmetic,
+// such that for example "-1" is math.MaxUint64.
+// Returns ErrOverflow alongside math.MaxUint64 (C's ERANGE) if the magnitude
+// of the value overflows a uint64, regardless of the sign.
+func Strtoul[S string | []byte](s S, base int) (v uint64, end int, err error) {
+	u, neg, end, err := strtoul(s, base)
+	switch {
+	case err == ErrSyntax:
+		return 0, 0, err
+	case err != nil:
+		return 1<<64 - 1, end, ErrOverflow
+	case neg:
+		return -u, end, nil
+	}
+	return u, end, nil
+}
+
+// strtoul parses the magnitude for Strtol and Strtoul.
+// Returns ErrOverflow if the magnitude overflows a uint64.
+func strtoul[S string | []byte](s S, base int) (
+	u uint64, neg bool, end int, err error,
+) {
+	if base != 0 && (base < 2 || base > 36) {
+		return 0, false, 0, ErrSyntax
+	}
+	i := 0
+	for i < len(s) && asciiSpace[s[i]] {
+		i++
+	}
+	if i < len(s) && (s[i] == '-' || s[i] == '+') {
+		neg = s[i] == '-'
+		i++
+	}
+	if (base == 0 || base == 16) && i+1 < len(s) && s[i] == '0' &&
+		(s[i+1] == 'x' || s[i+1] == 'X') {
+		if i+2 < len(s) && lutHex[s[i+2]] != invalidHexByte {
+			base, i = 16, i+2
+		} else { // The "0x" prefix isn't followed by a hex digit.
+			return 0, neg, i + 1, nil
+		}
+	} else if base == 0 {
+		base = 10
+		if i < len(s) && s[i] == '0' {
+			base = 8
+		}
+	}
+
+	start := i
+	for i < len(s) && strtolDigit(s[i]) < uint64(base) {
+		i++
+	}
+	if i == start { // No digits.
+		return 0, false, 0, ErrSyntax
+	}
+	digits := s[start:i]
+
+	switch base {
+	case 10:
+		u, err = Base10Uint64(digits)
+	case 16:
+		u, err = base16Uint64(digits)
+	default:
+		for _, c := range []byte(digits) {
+			hi, lo := bits.Mul64(u, uint64(base))
+			var carry uint64
+			u, carry = bits.Add64(lo, strtolDigit(c), 0)
+			if hi|carry != 0 {
+				return 0, neg, i, ErrOverflow
+			}
+		}
+	}
+	if err != nil {
+		return 0, neg, i, ErrOverflow // digits contains only valid digits.
+	}
+	return u, neg, i, nil
+}
+
+// strtolDigit returns the value of digit c in bases up to 36,
+// or a value greater than 36 if c isn't a digit.
+func strtolDigit(c byte) uint64 {
+	switch {
+	case c >= '0' && c <= '9':
+		return uint64(c - '0')
+	case c >= 'a' && c <= 'z':
+		return uint64(c-'a') + 10
+	case c >= 'A' && c <= 'Z':
+		return uint64(c-'A') + 10
+	}
+	return 0xff
+}
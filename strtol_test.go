@@ -0,0 +1,381 @@
+package parseint_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+// strtolGlibc is the reference behavior of glibc's strtol and strtoul
+// on x86-64 (64-bit long) for each input and base.
+// end is the endptr offset, erange{l,ul} report errno == ERANGE.
+// An end of 0 means that no conversion was performed.
+var strtolGlibc = []struct {
+	input    string
+	base     int
+	l        int64
+	ul       uint64
+	end      int
+	erangeL  bool
+	erangeUL bool
+}{
+	{"0", 0, 0, 0, 1, false, false},
+	{"0", 2, 0, 0, 1, false, false},
+	{"0", 8, 0, 0, 1, false, false},
+	{"0", 10, 0, 0, 1, false, false},
+	{"0", 16, 0, 0, 1, false, false},
+	{"0", 36, 0, 0, 1, false, false},
+	{" 42", 0, 42, 42, 3, false, false},
+	{" 42", 2, 0, 0, 0, false, false},
+	{" 42", 8, 34, 34, 3, false, false},
+	{" 42", 10, 42, 42, 3, false, false},
+	{" 42", 16, 66, 66, 3, false, false},
+	{" 42", 36, 146, 146, 3, false, false},
+	{"\t\n\v\f\r 42abc", 0, 42, 42, 8, false, false},
+	{"\t\n\v\f\r 42abc", 2, 0, 0, 0, false, false},
+	{"\t\n\v\f\r 42abc", 8, 34, 34, 8, false, false},
+	{"\t\n\v\f\r 42abc", 10, 42, 42, 8, false, false},
+	{"\t\n\v\f\r 42abc", 16, 273084, 273084, 11, false, false},
+	{"\t\n\v\f\r 42abc", 36, 6825144, 6825144, 11, false, false},
+	{"+42", 0, 42, 42, 3, false, false},
+	{"+42", 2, 0, 0, 0, false, false},
+	{"+42", 8, 34, 34, 3, false, false},
+	{"+42", 10, 42, 42, 3, false, false},
+	{"+42", 16, 66, 66, 3, false, false},
+	{"+42", 36, 146, 146, 3, false, false},
+	{"-42", 0, -42, 18446744073709551574, 3, false, false},
+	{"-42", 2, 0, 0, 0, false, false},
+	{"-42", 8, -34, 18446744073709551582, 3, false, false},
+	{"-42", 10, -42, 18446744073709551574, 3, false, false},
+	{"-42", 16, -66, 18446744073709551550, 3, false, false},
+	{"-42", 36, -146, 18446744073709551470, 3, false, false},
+	{"  -  42", 0, 0, 0, 0, false, false},
+	{"  -  42", 2, 0, 0, 0, false, false},
+	{"  -  42", 8, 0, 0, 0, false, false},
+	{"  -  42", 10, 0, 0, 0, false, false},
+	{"  -  42", 16, 0, 0, 0, false, false},
+	{"  -  42", 36, 0, 0, 0, false, false},
+	{"0x1A", 0, 26, 26, 4, false, false},
+	{"0x1A", 2, 0, 0, 1, false, false},
+	{"0x1A", 8, 0, 0, 1, false, false},
+	{"0x1A", 10, 0, 0, 1, false, false},
+	{"0x1A", 16, 26, 26, 4, false, false},
+	{"0x1A", 36, 42814, 42814, 4, false, false},
+	{"0X1a", 0, 26, 26, 4, false, false},
+	{"0X1a", 2, 0, 0, 1, false, false},
+	{"0X1a", 8, 0, 0, 1, false, false},
+	{"0X1a", 10, 0, 0, 1, false, false},
+	{"0X1a", 16, 26, 26, 4, false, false},
+	{"0X1a", 36, 42814, 42814, 4, false, false},
+	{"0x", 0, 0, 0, 1, false, false},
+	{"0x", 2, 0, 0, 1, false, false},
+	{"0x", 8, 0, 0, 1, false, false},
+	{"0x", 10, 0, 0, 1, false, false},
+	{"0x", 16, 0, 0, 1, false, false},
+	{"0x", 36, 33, 33, 2, false, false},
+	{"0xg", 0, 0, 0, 1, false, false},
+	{"0xg", 2, 0, 0, 1, false, false},
+	{"0xg", 8, 0, 0, 1, false, false},
+	{"0xg", 10, 0, 0, 1, false, false},
+	{"0xg", 16, 0, 0, 1, false, false},
+	{"0xg", 36, 1204, 1204, 3, false, false},
+	{"-0x10", 0, -16, 18446744073709551600, 5, false, false},
+	{"-0x10", 2, 0, 0, 2, false, false},
+	{"-0x10", 8, 0, 0, 2, false, false},
+	{"-0x10", 10, 0, 0, 2, false, false},
+	{"-0x10", 16, -16, 18446744073709551600, 5, false, false},
+	{"-0x10", 36, -42804, 18446744073709508812, 5, false, false},
+	{"010", 0, 8, 8, 3, false, false},
+	{"010", 2, 2, 2, 3, false, false},
+	{"010", 8, 8, 8, 3, false, false},
+	{"010", 10, 10, 10, 3, false, false},
+	{"010", 16, 16, 16, 3, false, false},
+	{"010", 36, 36, 36, 3, false, false},
+	{"08", 0, 0, 0, 1, false, false},
+	{"08", 2, 0, 0, 1, false, false},
+	{"08", 8, 0, 0, 1, false, false},
+	{"08", 10, 8, 8, 2, false, false},
+	{"08", 16, 8, 8, 2, false, false},
+	{"08", 36, 8, 8, 2, false, false},
+	{"0", 0, 0, 0, 1, false, false},
+	{"0", 2, 0, 0, 1, false, false},
+	{"0", 8, 0, 0, 1, false, false},
+	{"0", 10, 0, 0, 1, false, false},
+	{"0", 16, 0, 0, 1, false, false},
+	{"0", 36, 0, 0, 1, false, false},
+	{"-0", 0, 0, 0, 2, false, false},
+	{"-0", 2, 0, 0, 2, false, false},
+	{"-0", 8, 0, 0, 2, false, false},
+	{"-0", 10, 0, 0, 2, false, false},
+	{"-0", 16, 0, 0, 2, false, false},
+	{"-0", 36, 0, 0, 2, false, false},
+	{"", 0, 0, 0, 0, false, false},
+	{"", 2, 0, 0, 0, false, false},
+	{"", 8, 0, 0, 0, false, false},
+	{"", 10, 0, 0, 0, false, false},
+	{"", 16, 0, 0, 0, false, false},
+	{"", 36, 0, 0, 0, false, false},
+	{"   ", 0, 0, 0, 0, false, false},
+	{"   ", 2, 0, 0, 0, false, false},
+	{"   ", 8, 0, 0, 0, false, false},
+	{"   ", 10, 0, 0, 0, false, false},
+	{"   ", 16, 0, 0, 0, false, false},
+	{"   ", 36, 0, 0, 0, false, false},
+	{"+", 0, 0, 0, 0, false, false},
+	{"+", 2, 0, 0, 0, false, false},
+	{"+", 8, 0, 0, 0, false, false},
+	{"+", 10, 0, 0, 0, false, false},
+	{"+", 16, 0, 0, 0, false, false},
+	{"+", 36, 0, 0, 0, false, false},
+	{"-", 0, 0, 0, 0, false, false},
+	{"-", 2, 0, 0, 0, false, false},
+	{"-", 8, 0, 0, 0, false, false},
+	{"-", 10, 0, 0, 0, false, false},
+	{"-", 16, 0, 0, 0, false, false},
+	{"-", 36, 0, 0, 0, false, false},
+	{"abc", 0, 0, 0, 0, false, false},
+	{"abc", 2, 0, 0, 0, false, false},
+	{"abc", 8, 0, 0, 0, false, false},
+	{"abc", 10, 0, 0, 0, false, false},
+	{"abc", 16, 2748, 2748, 3, false, false},
+	{"abc", 36, 13368, 13368, 3, false, false},
+	{"9223372036854775807", 0, 9223372036854775807, 9223372036854775807, 19, false, false},
+	{"9223372036854775807", 2, 0, 0, 0, false, false},
+	{"9223372036854775807", 8, 0, 0, 0, false, false},
+	{"9223372036854775807", 10, 9223372036854775807, 9223372036854775807, 19, false, false},
+	{"9223372036854775807", 16, 9223372036854775807, 18446744073709551615, 19, true, true},
+	{"9223372036854775807", 36, 9223372036854775807, 18446744073709551615, 19, true, true},
+	{"9223372036854775808", 0, 9223372036854775807, 9223372036854775808, 19, true, false},
+	{"9223372036854775808", 2, 0, 0, 0, false, false},
+	{"9223372036854775808", 8, 0, 0, 0, false, false},
+	{"9223372036854775808", 10, 9223372036854775807, 9223372036854775808, 19, true, false},
+	{"9223372036854775808", 16, 9223372036854775807, 18446744073709551615, 19, true, true},
+	{"9223372036854775808", 36, 9223372036854775807, 18446744073709551615, 19, true, true},
+	{"-9223372036854775808", 0, -9223372036854775808, 9223372036854775808, 20, false, false},
+	{"-9223372036854775808", 2, 0, 0, 0, false, false},
+	{"-9223372036854775808", 8, 0, 0, 0, false, false},
+	{"-9223372036854775808", 10, -9223372036854775808, 9223372036854775808, 20, false, false},
+	{"-9223372036854775808", 16, -9223372036854775808, 18446744073709551615, 20, true, true},
+	{"-9223372036854775808", 36, -9223372036854775808, 18446744073709551615, 20, true, true},
+	{"-9223372036854775809", 0, -9223372036854775808, 9223372036854775807, 20, true, false},
+	{"-9223372036854775809", 2, 0, 0, 0, false, false},
+	{"-9223372036854775809", 8, 0, 0, 0, false, false},
+	{"-9223372036854775809", 10, -9223372036854775808, 9223372036854775807, 20, true, false},
+	{"-9223372036854775809", 16, -9223372036854775808, 18446744073709551615, 20, true, true},
+	{"-9223372036854775809", 36, -9223372036854775808, 18446744073709551615, 20, true, true},
+	{"99999999999999999999999xyz", 0, 9223372036854775807, 18446744073709551615, 23, true, true},
+	{"99999999999999999999999xyz", 2, 0, 0, 0, false, false},
+	{"99999999999999999999999xyz", 8, 0, 0, 0, false, false},
+	{"99999999999999999999999xyz", 10, 9223372036854775807, 18446744073709551615, 23, true, true},
+	{"99999999999999999999999xyz", 16, 9223372036854775807, 18446744073709551615, 23, true, true},
+	{"99999999999999999999999xyz", 36, 9223372036854775807, 18446744073709551615, 26, true, true},
+	{"18446744073709551615", 0, 9223372036854775807, 18446744073709551615, 20, true, false},
+	{"18446744073709551615", 2, 1, 1, 1, false, false},
+	{"18446744073709551615", 8, 1, 1, 1, false, false},
+	{"18446744073709551615", 10, 9223372036854775807, 18446744073709551615, 20, true, false},
+	{"18446744073709551615", 16, 9223372036854775807, 18446744073709551615, 20, true, true},
+	{"18446744073709551615", 36, 9223372036854775807, 18446744073709551615, 20, true, true},
+	{"18446744073709551616", 0, 9223372036854775807, 18446744073709551615, 20, true, true},
+	{"18446744073709551616", 2, 1, 1, 1, false, false},
+	{"18446744073709551616", 8, 1, 1, 1, false, false},
+	{"18446744073709551616", 10, 9223372036854775807, 18446744073709551615, 20, true, true},
+	{"18446744073709551616", 16, 9223372036854775807, 18446744073709551615, 20, true, true},
+	{"18446744073709551616", 36, 9223372036854775807, 18446744073709551615, 20, true, true},
+	{"-1", 0, -1, 18446744073709551615, 2, false, false},
+	{"-1", 2, -1, 18446744073709551615, 2, false, false},
+	{"-1", 8, -1, 18446744073709551615, 2, false, false},
+	{"-1", 10, -1, 18446744073709551615, 2, false, false},
+	{"-1", 16, -1, 18446744073709551615, 2, false, false},
+	{"-1", 36, -1, 18446744073709551615, 2, false, false},
+	{"-18446744073709551615", 0, -9223372036854775808, 1, 21, true, false},
+	{"-18446744073709551615", 2, -1, 18446744073709551615, 2, false, false},
+	{"-18446744073709551615", 8, -1, 18446744073709551615, 2, false, false},
+	{"-18446744073709551615", 10, -9223372036854775808, 1, 21, true, false},
+	{"-18446744073709551615", 16, -9223372036854775808, 18446744073709551615, 21, true, true},
+	{"-18446744073709551615", 36, -9223372036854775808, 18446744073709551615, 21, true, true},
+	{"-18446744073709551616", 0, -9223372036854775808, 18446744073709551615, 21, true, true},
+	{"-18446744073709551616", 2, -1, 18446744073709551615, 2, false, false},
+	{"-18446744073709551616", 8, -1, 18446744073709551615, 2, false, false},
+	{"-18446744073709551616", 10, -9223372036854775808, 18446744073709551615, 21, true, true},
+	{"-18446744073709551616", 16, -9223372036854775808, 18446744073709551615, 21, true, true},
+	{"-18446744073709551616", 36, -9223372036854775808, 18446744073709551615, 21, true, true},
+	{"zz", 0, 0, 0, 0, false, false},
+	{"zz", 2, 0, 0, 0, false, false},
+	{"zz", 8, 0, 0, 0, false, false},
+	{"zz", 10, 0, 0, 0, false, false},
+	{"zz", 16, 0, 0, 0, false, false},
+	{"zz", 36, 1295, 1295, 2, false, false},
+	{"ZZ", 0, 0, 0, 0, false, false},
+	{"ZZ", 2, 0, 0, 0, false, false},
+	{"ZZ", 8, 0, 0, 0, false, false},
+	{"ZZ", 10, 0, 0, 0, false, false},
+	{"ZZ", 16, 0, 0, 0, false, false},
+	{"ZZ", 36, 1295, 1295, 2, false, false},
+	{"1010", 0, 1010, 1010, 4, false, false},
+	{"1010", 2, 10, 10, 4, false, false},
+	{"1010", 8, 520, 520, 4, false, false},
+	{"1010", 10, 1010, 1010, 4, false, false},
+	{"1010", 16, 4112, 4112, 4, false, false},
+	{"1010", 36, 46692, 46692, 4, false, false},
+	{"777", 0, 777, 777, 3, false, false},
+	{"777", 2, 0, 0, 0, false, false},
+	{"777", 8, 511, 511, 3, false, false},
+	{"777", 10, 777, 777, 3, false, false},
+	{"777", 16, 1911, 1911, 3, false, false},
+	{"777", 36, 9331, 9331, 3, false, false},
+	{"0b101", 0, 0, 0, 1, false, false},
+	{"0b101", 2, 0, 0, 1, false, false},
+	{"0b101", 8, 0, 0, 1, false, false},
+	{"0b101", 10, 0, 0, 1, false, false},
+	{"0b101", 16, 45313, 45313, 5, false, false},
+	{"0b101", 36, 514513, 514513, 5, false, false},
+	{"12 34", 0, 12, 12, 2, false, false},
+	{"12 34", 2, 1, 1, 1, false, false},
+	{"12 34", 8, 10, 10, 2, false, false},
+	{"12 34", 10, 12, 12, 2, false, false},
+	{"12 34", 16, 18, 18, 2, false, false},
+	{"12 34", 36, 38, 38, 2, false, false},
+	{"1e5", 0, 1, 1, 1, false, false},
+	{"1e5", 2, 1, 1, 1, false, false},
+	{"1e5", 8, 1, 1, 1, false, false},
+	{"1e5", 10, 1, 1, 1, false, false},
+	{"1e5", 16, 485, 485, 3, false, false},
+	{"1e5", 36, 1805, 1805, 3, false, false},
+	{"  0x7fffffffffffffff", 0, 9223372036854775807, 9223372036854775807, 20, false, false},
+	{"  0x7fffffffffffffff", 2, 0, 0, 3, false, false},
+	{"  0x7fffffffffffffff", 8, 0, 0, 3, false, false},
+	{"  0x7fffffffffffffff", 10, 0, 0, 3, false, false},
+	{"  0x7fffffffffffffff", 16, 9223372036854775807, 9223372036854775807, 20, false, false},
+	{"  0x7fffffffffffffff", 36, 9223372036854775807, 18446744073709551615, 20, true, true},
+	{"0xffffffffffffffff", 0, 9223372036854775807, 18446744073709551615, 18, true, false},
+	{"0xffffffffffffffff", 2, 0, 0, 1, false, false},
+	{"0xffffffffffffffff", 8, 0, 0, 1, false, false},
+	{"0xffffffffffffffff", 10, 0, 0, 1, false, false},
+	{"0xffffffffffffffff", 16, 9223372036854775807, 18446744073709551615, 18, true, false},
+	{"0xffffffffffffffff", 36, 9223372036854775807, 18446744073709551615, 18, true, true},
+	{"0x10000000000000000", 0, 9223372036854775807, 18446744073709551615, 19, true, true},
+	{"0x10000000000000000", 2, 0, 0, 1, false, false},
+	{"0x10000000000000000", 8, 0, 0, 1, false, false},
+	{"0x10000000000000000", 10, 0, 0, 1, false, false},
+	{"0x10000000000000000", 16, 9223372036854775807, 18446744073709551615, 19, true, true},
+	{"0x10000000000000000", 36, 9223372036854775807, 18446744073709551615, 19, true, true},
+	{"-0x8000000000000000", 0, -9223372036854775808, 9223372036854775808, 19, false, false},
+	{"-0x8000000000000000", 2, 0, 0, 2, false, false},
+	{"-0x8000000000000000", 8, 0, 0, 2, false, false},
+	{"-0x8000000000000000", 10, 0, 0, 2, false, false},
+	{"-0x8000000000000000", 16, -9223372036854775808, 9223372036854775808, 19, false, false},
+	{"-0x8000000000000000", 36, -9223372036854775808, 18446744073709551615, 19, true, true},
+	{"0001", 0, 1, 1, 4, false, false},
+	{"0001", 2, 1, 1, 4, false, false},
+	{"0001", 8, 1, 1, 4, false, false},
+	{"0001", 10, 1, 1, 4, false, false},
+	{"0001", 16, 1, 1, 4, false, false},
+	{"0001", 36, 1, 1, 4, false, false},
+	{"3.14", 0, 3, 3, 1, false, false},
+	{"3.14", 2, 0, 0, 0, false, false},
+	{"3.14", 8, 3, 3, 1, false, false},
+	{"3.14", 10, 3, 3, 1, false, false},
+	{"3.14", 16, 3, 3, 1, false, false},
+	{"3.14", 36, 3, 3, 1, false, false},
+}
+
+func TestStrtol(t *testing.T) {
+	for _, td := range strtolGlibc {
+		check := func(input any, l int64, lEnd int, lErr error,
+			ul uint64, ulEnd int, ulErr error,
+		) {
+			switch {
+			case td.end == 0:
+				require.ErrorIs(t, lErr, parseint.ErrSyntax, "%q %d", input, td.base)
+				require.ErrorIs(t, ulErr, parseint.ErrSyntax, "%q %d", input, td.base)
+			default:
+				if td.erangeL {
+					require.ErrorIs(t, lErr, parseint.ErrOverflow, "%q %d", input, td.base)
+				} else {
+					require.NoError(t, lErr, "%q %d", input, td.base)
+				}
+				if td.erangeUL {
+					require.ErrorIs(t, ulErr, parseint.ErrOverflow, "%q %d", input, td.base)
+				} else {
+					require.NoError(t, ulErr, "%q %d", input, td.base)
+				}
+			}
+			require.Equal(t, td.l, l, "%q %d", input, td.base)
+			require.Equal(t, td.end, lEnd, "%q %d", input, td.base)
+			require.Equal(t, td.ul, ul, "%q %d", input, td.base)
+			require.Equal(t, td.end, ulEnd, "%q %d", input, td.base)
+		}
+
+		l, lEnd, lErr := parseint.Strtol(td.input, td.base)
+		ul, ulEnd, ulErr := parseint.Strtoul(td.input, td.base)
+		check(td.input, l, lEnd, lErr, ul, ulEnd, ulErr)
+
+		l, lEnd, lErr = parseint.Strtol([]byte(td.input), td.base)
+		ul, ulEnd, ulErr = parseint.Strtoul([]byte(td.input), td.base)
+		check([]byte(td.input), l, lEnd, lErr, ul, ulEnd, ulErr)
+	}
+
+	t.Run("invalid_base", func(t *testing.T) {
+		for _, base := range []int{-1, 1, 37, 64} {
+			l, end, err := parseint.Strtol("12", base)
+			require.ErrorIs(t, err, parseint.ErrSyntax)
+			require.Zero(t, l)
+			require.Zero(t, end)
+			ul, end, err := parseint.Strtoul("12", base)
+			require.ErrorIs(t, err, parseint.ErrSyntax)
+			require.Zero(t, ul)
+			require.Zero(t, end)
+		}
+	})
+}
+
+func FuzzStrtol(f *testing.F) {
+	for _, td := range strtolGlibc {
+		f.Add(td.input, uint8(td.base))
+	}
+
+	f.Fuzz(func(t *testing.T, s string, base uint8) {
+		b := int(base % 37)
+		if b == 1 {
+			b = 0
+		}
+		v, end, err := parseint.Strtol(s, b)
+		if end < 0 || end > len(s) {
+			t.Fatalf("%q: end out of bounds: %d", s, end)
+		}
+		if err == parseint.ErrSyntax {
+			if v != 0 || end != 0 {
+				t.Fatalf("%q: ErrSyntax with %d, %d", s, v, end)
+			}
+			return
+		}
+
+		// Parsing the consumed prefix must yield the same result.
+		v2, end2, err2 := parseint.Strtol(s[:end], b)
+		if v2 != v || end2 != end || err2 != err {
+			t.Fatalf("%q: prefix %q: expected %d, %d, %v; received: %d, %d, %v",
+				s, s[:end], v, end, err, v2, end2, err2)
+		}
+
+		// strconv accepts the trimmed prefix except for the "0x" prefix
+		// in base 16 and the leading whitespace.
+		prefix := s[:end]
+		for len(prefix) > 0 && (prefix[0] == ' ' || (prefix[0] >= '\t' && prefix[0] <= '\r')) {
+			prefix = prefix[1:]
+		}
+		if b == 0 || b == 16 {
+			return
+		}
+		std, errStd := strconv.ParseInt(prefix, b, 64)
+		if errStd != nil {
+			if err != parseint.ErrOverflow {
+				t.Fatalf("%q: expected ErrOverflow (%v); received: %d, %v", s, errStd, v, err)
+			}
+		}
+		if std != v {
+			t.Fatalf("%q: expected %d; received: %d", s, std, v)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package parseint
+
+// TOMLInt64 parses s as a TOML v1.0.0 integer (https://toml.io/en/v1.0.0#integer).
+// Decimal integers may have a '+' or '-' sign and must not have leading zeroes
+// except for "0", "+0" and "-0".
+// Hexadecimal, octal and binary integers must be prefixed with "0x", "0o"
+// and "0b" respectively, must not have a sign, and may have leading zeroes.
+// Hexadecimal digits are case-insensitive, prefixes must be lower case.
+// Underscores are allowed only between two digits.
+// Returns ErrSyntax if s isn't a valid TOML integer.
+// Returns ErrOverflow if the stringified value overflows an int64.
+func TOMLInt64[S string | []byte](s S) (int64, error) {
+	if len(s) == 0 {
+		return 0, ErrSyntax
+	}
+	const max = uint64(1<<63 - 1)
+	if len(s) > 2 && s[0] == '0' {
+		var n uint64
+		var err error
+		switch s[1] {
+		case 'x':
+			n, err = parseDigits(s[2:], 16, max, underscoresBetween)
+		case 'o':
+			n, err = parseDigits(s[2:], 8, max, underscoresBetween)
+		case 'b':
+			n, err = parseDigits(s[2:], 2, max, underscoresBetween)
+		default:
+			return 0, ErrSyntax // Leading zero.
+		}
+		return int64(n), err
+	}
+
+	switch s[0] {
+	case '-': // Negative integer.
+		s = s[1:]
+		if len(s) == 0 { // Sign without any following digits.
+			return 0, ErrSyntax
+		}
+		if s[0] == '0' {
+			if len(s) == 1 {
+				return 0, nil
+			}
+			return 0, ErrSyntax // Leading zero.
+		}
+		n, err := parseDigits(s, 10, max+1, underscoresBetween)
+		return int64(-n), err
+	case '+':
+		s = s[1:]
+		if len(s) == 0 { // Sign without any following digits.
+			return 0, ErrSyntax
+		}
+	}
+	if s[0] == '0' {
+		if len(s) == 1 {
+			return 0, nil
+		}
+		return 0, ErrSyntax // Leading zero.
+	}
+	n, err := parseDigits(s, 10, max, underscoresBetween)
+	return int64(n), err
+}
@@ -0,0 +1,190 @@
+package parseint_test
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+// validTOMLInt64 is derived from the toml-test valid/integer cases.
+var validTOMLInt64 = map[string]int64{
+	// integer.toml
+	"42":  42,
+	"+42": 42,
+	"-17": -17,
+	"0":   0,
+
+	// literals.toml
+	"0b11010110":  0b11010110,
+	"0b1_0_1":     0b101,
+	"0o01234567":  0o1234567,
+	"0o755":       0o755,
+	"0xDEADBEEF":  0xdeadbeef,
+	"0xdeadbeef":  0xdeadbeef,
+	"0xdead_beef": 0xdeadbeef,
+	"0x00987":     0x987,
+
+	// long.toml
+	"9223372036854775807":  math.MaxInt64,
+	"-9223372036854775808": math.MinInt64,
+
+	// underscore.toml
+	"1_000":   1000,
+	"1_1_1_1": 1111,
+
+	// zero.toml
+	"+0":      0,
+	"-0":      0,
+	"0x0":     0,
+	"0x00":    0,
+	"0x00000": 0,
+	"0o0":     0,
+	"0o00":    0,
+	"0o00000": 0,
+	"0b0":     0,
+	"0b00":    0,
+	"0b00000": 0,
+
+	"0x7fffffffffffffff":      math.MaxInt64,
+	"0x7FFF_FFFF_FFFF_FFFF":   math.MaxInt64,
+	"0o777777777777777777777": math.MaxInt64,
+	"0b111111111111111111111111111111111111111111111111111111111111111": math.MaxInt64,
+	"9_223_372_036_854_775_807":                                         math.MaxInt64,
+	"-9_223_372_036_854_775_808":                                        math.MinInt64,
+}
+
+// invalidTOMLInt64 is derived from the toml-test invalid/integer cases.
+var invalidTOMLInt64 = map[string]error{
+	"":                        parseint.ErrSyntax,
+	"0B0":                     parseint.ErrSyntax, // capital-bin
+	"0X1":                     parseint.ErrSyntax, // capital-hex
+	"0O0":                     parseint.ErrSyntax, // capital-oct
+	"--99":                    parseint.ErrSyntax, // double-sign-nex
+	"++99":                    parseint.ErrSyntax, // double-sign-plus
+	"0b":                      parseint.ErrSyntax, // incomplete-bin
+	"0x":                      parseint.ErrSyntax, // incomplete-hex
+	"0o":                      parseint.ErrSyntax, // incomplete-oct
+	"0b0012":                  parseint.ErrSyntax, // invalid-bin
+	"0xaafz":                  parseint.ErrSyntax, // invalid-hex
+	"0o778":                   parseint.ErrSyntax, // invalid-oct
+	"_123":                    parseint.ErrSyntax, // leading-us
+	"_0b1":                    parseint.ErrSyntax, // leading-us-bin
+	"_0x1":                    parseint.ErrSyntax, // leading-us-hex
+	"_0o1":                    parseint.ErrSyntax, // leading-us-oct
+	"01":                      parseint.ErrSyntax, // leading-zero-1
+	"00":                      parseint.ErrSyntax, // leading-zero-2
+	"0_0":                     parseint.ErrSyntax, // leading-zero-3
+	"-01":                     parseint.ErrSyntax, // leading-zero-sign-1
+	"+01":                     parseint.ErrSyntax, // leading-zero-sign-2
+	"+0_1":                    parseint.ErrSyntax, // leading-zero-sign-3
+	"-0b11010110":             parseint.ErrSyntax, // negative-bin
+	"-0xff":                   parseint.ErrSyntax, // negative-hex
+	"-0o755":                  parseint.ErrSyntax, // negative-oct
+	"+0b11010110":             parseint.ErrSyntax, // positive-bin
+	"+0xff":                   parseint.ErrSyntax, // positive-hex
+	"+0o755":                  parseint.ErrSyntax, // positive-oct
+	"42 the ultimate answer?": parseint.ErrSyntax, // text-after-integer
+	"123_":                    parseint.ErrSyntax, // trailing-us
+	"0b1_":                    parseint.ErrSyntax, // trailing-us-bin
+	"0x1_":                    parseint.ErrSyntax, // trailing-us-hex
+	"0o1_":                    parseint.ErrSyntax, // trailing-us-oct
+	"0b_1":                    parseint.ErrSyntax, // us-after-bin
+	"0x_1":                    parseint.ErrSyntax, // us-after-hex
+	"0o_1":                    parseint.ErrSyntax, // us-after-oct
+	"1__23":                   parseint.ErrSyntax, // double-us
+	"-":                       parseint.ErrSyntax,
+	"+":                       parseint.ErrSyntax,
+	"-_1":                     parseint.ErrSyntax,
+	"1.0":                     parseint.ErrSyntax,
+	"1e3":                     parseint.ErrSyntax,
+	"inf":                     parseint.ErrSyntax,
+	" 1":                      parseint.ErrSyntax,
+	"0xg":                     parseint.ErrSyntax,
+	"0x_":                     parseint.ErrSyntax,
+
+	"9223372036854775808":      parseint.ErrOverflow, // overflow
+	"-9223372036854775809":     parseint.ErrOverflow, // underflow
+	"0x1_0000_0000_0000_0000":  parseint.ErrOverflow, // overflow-hex
+	"0x8000000000000000":       parseint.ErrOverflow,
+	"0o1000000000000000000000": parseint.ErrOverflow, // overflow-oct
+	"0b1000000000000000000000000000000000000000000000000000000000000000": parseint.ErrOverflow, // overflow-bin
+	"99999999999999999999999999":                                         parseint.ErrOverflow,
+}
+
+func TestTOMLInt64(t *testing.T) {
+	callTOMLInt64 := func(input string, fn func(int64, error)) {
+		fn(parseint.TOMLInt64(input))
+		fn(parseint.TOMLInt64([]byte(input)))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range validTOMLInt64 {
+			callTOMLInt64(input, func(actual int64, err error) {
+				require.NoError(t, err, "%q", input)
+				require.Equal(t, expect, actual, "%q", input)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range invalidTOMLInt64 {
+			callTOMLInt64(input, func(a int64, err error) {
+				require.ErrorIs(t, err, expectedErr, "%q", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+
+	t.Run("range_10k", func(t *testing.T) {
+		for i := int64(-10_000); i <= 10_000; i++ {
+			for _, base := range []struct {
+				prefix string
+				base   int
+			}{{"", 10}, {"0x", 16}, {"0o", 8}, {"0b", 2}} {
+				if i < 0 && base.base != 10 {
+					continue
+				}
+				input := base.prefix + strconv.FormatInt(i, base.base)
+				callTOMLInt64(input, func(actual int64, err error) {
+					require.NoError(t, err, "%q", input)
+					require.Equal(t, i, actual, "%q", input)
+				})
+			}
+		}
+	})
+}
+
+func FuzzTOMLInt64(f *testing.F) {
+	for input := range validTOMLInt64 {
+		f.Add(input)
+	}
+	for input := range invalidTOMLInt64 {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		x, err := parseint.TOMLInt64(s)
+		if err != nil {
+			if x != 0 {
+				t.Errorf("%q: failed but returned non-zero value: %x", s, x)
+			}
+			return
+		}
+		// strconv accepts the TOML syntax with base prefix 0 except for
+		// leading zeroes and signed prefixed numbers which are rejected above.
+		if strings.HasPrefix(strings.TrimLeft(s, "+-"), "0") &&
+			strings.TrimLeft(s, "+-") != "0" && s[0] != '0' {
+			t.Fatalf("must have returned error but didn't: %q", s)
+		}
+		std, errStd := strconv.ParseInt(s, 0, 64)
+		if errStd != nil {
+			t.Fatalf("must have returned error %v but didn't: %q", errStd, s)
+		} else if std != x {
+			t.Errorf("expected %d; received: %d", std, x)
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package parseint
+
+// TrimSpace parses s using parse after skipping any leading and trailing
+// ASCII whitespace characters ('\t', '\n', '\v', '\f', '\r' and ' ').
+// Errors returned by parse are returned unchanged.
+// TrimSpace is comparable to parse(strings.TrimSpace(s)) and
+// parse(bytes.TrimSpace(s)) but doesn't consider non-ASCII whitespace.
+//
+//	v, err := parseint.TrimSpace(" 42\r", parseint.Base10Int64[string])
+func TrimSpace[S string | []byte, T any](s S, parse func(S) (T, error)) (T, error) {
+	return parse(trimSpace(s))
+}
+
+// trimSpace returns s without any leading and trailing ASCII whitespace.
+func trimSpace[S string | []byte](s S) S {
+	for len(s) > 0 && asciiSpace[s[0]] {
+		s = s[1:]
+	}
+	for len(s) > 0 && asciiSpace[s[len(s)-1]] {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// asciiSpace is a lookup table marking ASCII whitespace characters.
+var asciiSpace = [256]bool{
+	'\t': true, '\n': true, '\v': true, '\f': true, '\r': true, ' ': true,
+}
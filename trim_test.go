@@ -0,0 +1,130 @@
+package parseint_test
+
+import (
+	"math"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+var validTrimSpaceBase10Int64 = map[string]int64{
+	"0":                        0,
+	" 0":                       0,
+	"0 ":                       0,
+	" 42 ":                     42,
+	"42\r":                     42,
+	"42\r\n":                   42,
+	"\t\n\v\f\r 42\t\n\v\f\r ": 42,
+	"  -42":                    -42,
+	"+42  ":                    42,
+	" 9223372036854775807 ":    math.MaxInt64,
+	" -9223372036854775808 ":   math.MinInt64,
+}
+
+var invalidTrimSpaceBase10Int64 = map[string]error{
+	"":                      parseint.ErrSyntax,
+	" ":                     parseint.ErrSyntax,
+	" \t\r\n":               parseint.ErrSyntax,
+	"4 2":                   parseint.ErrSyntax,
+	"- 42":                  parseint.ErrSyntax,
+	"\x0042":                parseint.ErrSyntax,
+	" 42":                   parseint.ErrSyntax, // Non-ASCII whitespace.
+	"42　":                   parseint.ErrSyntax, // Non-ASCII whitespace.
+	" 9223372036854775808 ": parseint.ErrOverflow,
+}
+
+func TestTrimSpace(t *testing.T) {
+	callTrimSpace := func(input string, fn func(int64, error)) {
+		fn(parseint.TrimSpace(input, parseint.Base10Int64[string]))
+		fn(parseint.TrimSpace([]byte(input), parseint.Base10Int64[[]byte]))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range validTrimSpaceBase10Int64 {
+			callTrimSpace(input, func(actual int64, err error) {
+				require.NoError(t, err, "%q", input)
+				require.Equal(t, expect, actual, "%q", input)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range invalidTrimSpaceBase10Int64 {
+			callTrimSpace(input, func(a int64, err error) {
+				require.ErrorIs(t, err, expectedErr, "%q", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+
+	t.Run("base16", func(t *testing.T) {
+		v16, err := parseint.TrimSpace(" ffff\n", parseint.Base16Uint16[string, uint16])
+		require.NoError(t, err)
+		require.Equal(t, uint16(0xffff), v16)
+
+		v32, err := parseint.TrimSpace([]byte("\tFFFFFFFF "),
+			parseint.Base16Uint32[[]byte, uint32])
+		require.NoError(t, err)
+		require.Equal(t, uint32(0xffffffff), v32)
+	})
+}
+
+func FuzzTrimSpace(f *testing.F) {
+	for input := range validTrimSpaceBase10Int64 {
+		f.Add(input)
+	}
+	for input := range invalidTrimSpaceBase10Int64 {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		x, err := parseint.TrimSpace(s, parseint.Base10Int64[string])
+		std, errStd := strconv.ParseInt(strings.Trim(s, "\t\n\v\f\r "), 10, 64)
+		if err == nil {
+			if errStd != nil {
+				t.Fatalf("must have returned error %v but didn't: %q", errStd, s)
+			} else if std != x {
+				t.Errorf("expected %d; received: %d", std, x)
+			}
+		} else {
+			if x != 0 {
+				t.Errorf("%q: failed but returned non-zero value: %x", s, x)
+			}
+			if errStd == nil {
+				t.Fatalf("unexpected error for input %q: %v", s, err)
+			}
+		}
+	})
+}
+
+func BenchmarkTrimSpace(b *testing.B) {
+	fn := getBenchmarkFn(b, func(s string) (int64, error) {
+		return strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	}, func(s string) (int64, error) {
+		return parseint.TrimSpace(s, parseint.Base10Int64[string])
+	})
+
+	var a int64
+	var err error
+	for _, td := range []struct {
+		name  string
+		input string
+	}{
+		{"none", "12345"},
+		{"both", " 12345 "},
+		{"crlf", "12345\r\n"},
+		{"syntax", " 12 345 "},
+	} {
+		b.Run(td.name+"/string", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				a, err = fn(td.input)
+			}
+		})
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
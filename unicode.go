@@ -0,0 +1,134 @@
+package parseint
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Base10Int64Unicode parses s as a base-10 signed 64-bit integer where digits
+// may be from any Unicode decimal digit (Nd) block, for example
+// Arabic-Indic (٠-٩), Extended Arabic-Indic (۰-۹), Devanagari (०-९)
+// or fullwidth (０-９) digits.
+// All digits of a number must be from the same block, mixing digits of
+// different scripts (including ASCII) in one number is rejected.
+// The sign must be an ASCII '-' or '+'.
+// Returns ErrSyntax if s contains an invalid character or mixes scripts.
+// Returns ErrOverflow if the stringified value overflows an int64.
+// Input with an ASCII first digit is passed to Base10Int64 directly.
+func Base10Int64Unicode[S string | []byte](s S) (int64, error) {
+	i := 0
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		i = 1
+	}
+	if i == len(s) || s[i] < utf8.RuneSelf {
+		// Any non-ASCII digit following an ASCII one is rejected
+		// as mixing scripts, so Base10Int64 handles the whole input.
+		return Base10Int64(s)
+	}
+
+	neg := s[0] == '-'
+	s = s[i:]
+	max := uint64(1<<63 - 1)
+	if neg {
+		max = 1 << 63
+	}
+
+	var n uint64
+	var zero rune = -1 // Code point of digit zero of the script of s.
+	for len(s) > 0 {
+		r, size := decodeRune(s)
+		s = s[size:]
+		z, ok := unicodeDigitZero(r)
+		if !ok {
+			return 0, ErrSyntax
+		}
+		if zero != z {
+			if zero != -1 { // Mixed scripts.
+				return 0, ErrSyntax
+			}
+			zero = z
+		}
+		d := uint64(r - z)
+		if n > (max-d)/10 {
+			return 0, ErrOverflow
+		}
+		n = n*10 + d
+	}
+	if zero == -1 { // No digits
+		return 0, ErrSyntax
+	}
+	if neg {
+		return int64(-n), nil
+	}
+	return int64(n), nil
+}
+
+// decodeRune is equivalent to utf8.DecodeRune and utf8.DecodeRuneInString
+// but avoids converting between string and []byte. s must not be empty.
+func decodeRune[S string | []byte](s S) (r rune, size int) {
+	c0 := s[0]
+	switch {
+	case c0 < utf8.RuneSelf:
+		return rune(c0), 1
+	case c0 >= 0xc2 && c0 <= 0xdf && len(s) > 1 && s[1]&0xc0 == 0x80:
+		return rune(c0&0x1f)<<6 | rune(s[1]&0x3f), 2
+	case c0 >= 0xe0 && c0 <= 0xef && len(s) > 2 &&
+		s[1]&0xc0 == 0x80 && s[2]&0xc0 == 0x80:
+		r = rune(c0&0x0f)<<12 | rune(s[1]&0x3f)<<6 | rune(s[2]&0x3f)
+		// Reject overlong encodings and surrogates.
+		if r >= 0x800 && (r < 0xd800 || r > 0xdfff) {
+			return r, 3
+		}
+	case c0 >= 0xf0 && c0 <= 0xf4 && len(s) > 3 &&
+		s[1]&0xc0 == 0x80 && s[2]&0xc0 == 0x80 && s[3]&0xc0 == 0x80:
+		r = rune(c0&0x07)<<18 | rune(s[1]&0x3f)<<12 |
+			rune(s[2]&0x3f)<<6 | rune(s[3]&0x3f)
+		// Reject overlong encodings and code points beyond utf8.MaxRune.
+		if r >= 0x10000 && r <= utf8.MaxRune {
+			return r, 4
+		}
+	}
+	return utf8.RuneError, 1
+}
+
+// unicodeDigitZero returns the code point of digit zero
+// of the Unicode Nd block r belongs to, or ok=false if r isn't
+// a Unicode decimal digit.
+func unicodeDigitZero(r rune) (zero rune, ok bool) {
+	// Every range of unicode.Nd has stride 1 and consists of
+	// one or more consecutive blocks of 10 digits starting at zero.
+	if r <= unicode.MaxLatin1 {
+		if r >= '0' && r <= '9' {
+			return '0', true
+		}
+		return 0, false
+	}
+	if r <= 0xffff {
+		t := unicode.Nd.R16
+		for len(t) > 0 { // Binary search
+			m := len(t) / 2
+			switch rg := t[m]; {
+			case r < rune(rg.Lo):
+				t = t[:m]
+			case r > rune(rg.Hi):
+				t = t[m+1:]
+			default:
+				return r - (r-rune(rg.Lo))%10, true
+			}
+		}
+		return 0, false
+	}
+	t := unicode.Nd.R32
+	for len(t) > 0 { // Binary search
+		m := len(t) / 2
+		switch rg := t[m]; {
+		case r < rune(rg.Lo):
+			t = t[:m]
+		case r > rune(rg.Hi):
+			t = t[m+1:]
+		default:
+			return r - (r-rune(rg.Lo))%10, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,168 @@
+package parseint_test
+
+import (
+	"math"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+var validBase10Int64Unicode = map[string]int64{
+	"0":                   0,
+	"42":                  42,
+	"-42":                 -42,
+	"٤٢":                  42, // Arabic-Indic
+	"-٤٢":                 -42,
+	"+٤٢":                 42,
+	"۴۲":                  42, // Extended Arabic-Indic
+	"४२":                  42, // Devanagari
+	"৪২":                  42, // Bengali
+	"４２":                  42, // Fullwidth
+	"๔๒":                  42, // Thai
+	"𝟒𝟐":                  42, // Mathematical bold
+	"𝟜𝟚":                  42, // Mathematical double-struck
+	"٠":                   0,
+	"-٠":                  0,
+	"٠٠٠١":                1,
+	"٩٢٢٣٣٧٢٠٣٦٨٥٤٧٧٥٨٠٧": math.MaxInt64,
+	"-٩٢٢٣٣٧٢٠٣٦٨٥٤٧٧٥٨٠٨": math.MinInt64,
+	"９２２３３７２０３６８５４７７５８０７":  math.MaxInt64,
+}
+
+var invalidBase10Int64Unicode = map[string]error{
+	"":       parseint.ErrSyntax,
+	"-":      parseint.ErrSyntax,
+	"+":      parseint.ErrSyntax,
+	"٤2":     parseint.ErrSyntax, // Mixed Arabic-Indic and ASCII
+	"4٢":     parseint.ErrSyntax, // Mixed ASCII and Arabic-Indic
+	"-4٢":    parseint.ErrSyntax,
+	"٤۲":     parseint.ErrSyntax, // Mixed Arabic-Indic and Extended Arabic-Indic
+	"४२٢":    parseint.ErrSyntax, // Mixed Devanagari and Arabic-Indic
+	"𝟒𝟚":     parseint.ErrSyntax, // Mixed mathematical bold and double-struck
+	"٤ ٢":    parseint.ErrSyntax,
+	" ٤٢":    parseint.ErrSyntax,
+	"--٤٢":   parseint.ErrSyntax,
+	"−٤٢":    parseint.ErrSyntax, // Non-ASCII sign
+	"٤٢-":    parseint.ErrSyntax,
+	"٤.٢":    parseint.ErrSyntax,
+	"ж":      parseint.ErrSyntax,
+	"Ⅻ":      parseint.ErrSyntax, // Nl, not Nd
+	"²":      parseint.ErrSyntax, // No, not Nd
+	"\xff٤":  parseint.ErrSyntax,
+	"٤\xd9":  parseint.ErrSyntax,
+	"٤\xd9b": parseint.ErrSyntax,
+
+	"٩٢٢٣٣٧٢٠٣٦٨٥٤٧٧٥٨٠٨":   parseint.ErrOverflow,
+	"-٩٢٢٣٣٧٢٠٣٦٨٥٤٧٧٥٨٠٩":  parseint.ErrOverflow,
+	"９９９９９９９９９９９９９９９９９９９９９": parseint.ErrOverflow,
+}
+
+func TestBase10Int64Unicode(t *testing.T) {
+	callBase10Int64Unicode := func(input string, fn func(int64, error)) {
+		fn(parseint.Base10Int64Unicode(input))
+		fn(parseint.Base10Int64Unicode([]byte(input)))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for input, expect := range validBase10Int64Unicode {
+			callBase10Int64Unicode(input, func(actual int64, err error) {
+				require.NoError(t, err, "%q", input)
+				require.Equal(t, expect, actual, "%q", input)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for input, expectedErr := range invalidBase10Int64Unicode {
+			callBase10Int64Unicode(input, func(a int64, err error) {
+				require.ErrorIs(t, err, expectedErr, "%q", input)
+				require.Zero(t, a)
+			})
+		}
+	})
+
+	t.Run("all_nd_blocks", func(t *testing.T) {
+		check := func(lo, hi rune) {
+			for zero := lo; zero <= hi; zero += 10 {
+				var b strings.Builder
+				for _, d := range "9876543210" {
+					b.WriteRune(zero + (d - '0'))
+				}
+				input := b.String()
+				callBase10Int64Unicode(input, func(actual int64, err error) {
+					require.NoError(t, err, "%q", input)
+					require.Equal(t, int64(9876543210), actual, "%q", input)
+				})
+			}
+		}
+		for _, r := range unicode.Nd.R16 {
+			check(rune(r.Lo), rune(r.Hi))
+		}
+		for _, r := range unicode.Nd.R32 {
+			check(rune(r.Lo), rune(r.Hi))
+		}
+	})
+
+	t.Run("range_10k", func(t *testing.T) {
+		for i := int64(-10_000); i <= 10_000; i++ {
+			input := strings.Map(func(r rune) rune {
+				if r >= '0' && r <= '9' {
+					return r - '0' + '٠'
+				}
+				return r
+			}, strconv.FormatInt(i, 10))
+			callBase10Int64Unicode(input, func(actual int64, err error) {
+				require.NoError(t, err, "%q", input)
+				require.Equal(t, i, actual, "%q", input)
+			})
+		}
+	})
+
+	t.Run("noalloc", func(t *testing.T) {
+		input := []byte("-٩٢٢٣٣٧٢٠٣٦٨٥٤٧٧٥٨٠٨")
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = parseint.Base10Int64Unicode(input)
+		})
+		require.Zero(t, allocs)
+	})
+}
+
+func BenchmarkBase10Int64Unicode(b *testing.B) {
+	fn := getBenchmarkFn(b, func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 64)
+	}, parseint.Base10Int64Unicode[string])
+	fnBytes := getBenchmarkFn(b, func(s []byte) (int64, error) {
+		return strconv.ParseInt(string(s), 10, 64)
+	}, parseint.Base10Int64Unicode[[]byte])
+
+	var a int64
+	var err error
+	for _, td := range []struct {
+		name  string
+		input string
+	}{
+		{"ascii", "-9876543210123"},
+		{"arabic", "-٩٨٧٦٥٤٣٢١٠١٢٣"},
+		{"fullwidth", "-９８７６５４３２１０１２３"},
+		{"syntax", "٤2"},
+	} {
+		b.Run(td.name+"/string", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				a, err = fn(td.input)
+			}
+		})
+		inputBytes := []byte(td.input)
+		b.Run(td.name+"/bytes", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				a, err = fnBytes(inputBytes)
+			}
+		})
+	}
+	runtime.KeepAlive(a)
+	runtime.KeepAlive(err)
+}
@@ -0,0 +1,154 @@
+package parseint
+
+// YAML12Int64 resolves the plain scalar s according to the integer rules
+// of the YAML 1.2 core schema:
+//
+//	[-+]?[0-9]+          # base 10
+//	0o[0-7]+             # base 8
+//	0x[0-9a-fA-F]+       # base 16
+//
+// isInt is false if s doesn't match any of the above, in which case
+// the scalar must be resolved to a different type and err is nil.
+// Returns ErrOverflow with isInt=true if s is an integer that overflows an int64.
+func YAML12Int64[S string | []byte](s S) (v int64, isInt bool, err error) {
+	if len(s) > 2 && s[0] == '0' {
+		switch s[1] {
+		case 'o':
+			u, err := parseDigits(s[2:], 8, 1<<64-1, underscoresNone)
+			return yamlResult(u, false, err)
+		case 'x':
+			u, err := base16Uint64(s[2:])
+			return yamlResult(u, false, err)
+		}
+	}
+	v, err = Base10Int64(s)
+	switch {
+	case err == nil:
+		return v, true, nil
+	case err == ErrOverflow && isSignedDecimal(s):
+		// Base10Int64 may report an overflow before reaching an invalid character.
+		return 0, true, err
+	}
+	return 0, false, nil
+}
+
+// isSignedDecimal returns true if s matches [-+]?[0-9]+
+func isSignedDecimal[S string | []byte](s S) bool {
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		s = s[1:]
+	}
+	if len(s) == 0 {
+		return false
+	}
+	for _, c := range []byte(s) {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// YAML11Int64 resolves the plain scalar s according to the integer rules
+// of the YAML 1.1 type repository (https://yaml.org/type/int.html):
+//
+//	[-+]?0b[0-1_]+                    # base 2
+//	[-+]?0[0-7_]+                     # base 8
+//	[-+]?(0|[1-9][0-9_]*)             # base 10
+//	[-+]?0x[0-9a-fA-F_]+              # base 16
+//	[-+]?[1-9][0-9_]*(:[0-5]?[0-9])+  # base 60
+//
+// isInt is false if s doesn't match any of the above, in which case
+// the scalar must be resolved to a different type and err is nil.
+// Returns ErrOverflow with isInt=true if s is an integer that overflows an int64.
+func YAML11Int64[S string | []byte](s S) (v int64, isInt bool, err error) {
+	if len(s) == 0 {
+		return 0, false, nil
+	}
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	if len(s) == 0 { // Sign without any following digits.
+		return 0, false, nil
+	}
+	max := uint64(1<<63 - 1)
+	if neg {
+		max = 1 << 63
+	}
+
+	var u uint64
+	switch {
+	case s[0] == '0' && len(s) == 1:
+		return 0, true, nil
+	case s[0] == '0' && s[1] == 'b':
+		u, err = parseDigits(s[2:], 2, max, underscoresAnywhere)
+	case s[0] == '0' && s[1] == 'x':
+		u, err = parseDigits(s[2:], 16, max, underscoresAnywhere)
+	case s[0] == '0':
+		u, err = parseDigits(s[1:], 8, max, underscoresAnywhere)
+	case s[0] < '1' || s[0] > '9':
+		return 0, false, nil
+	default:
+		u, err = yaml11Sexagesimal(s, max)
+	}
+	return yamlResult(u, neg, err)
+}
+
+// yamlResult converts the result of a magnitude parser
+// to the result of a YAML resolver function.
+func yamlResult(u uint64, neg bool, err error) (int64, bool, error) {
+	switch {
+	case err == ErrOverflow:
+		return 0, true, err
+	case err != nil:
+		return 0, false, nil
+	case neg:
+		return int64(-u), true, nil
+	case u > 1<<63-1:
+		return 0, true, ErrOverflow
+	}
+	return int64(u), true, nil
+}
+
+// yaml11Sexagesimal parses s as a base-10 YAML 1.1 integer with optional
+// base-60 components separated by colons, for example "1_000" or "190:20:30".
+// s must begin with a non-zero digit.
+func yaml11Sexagesimal[S string | []byte](s S, max uint64) (uint64, error) {
+	i := 0
+	for i < len(s) && s[i] != ':' {
+		i++
+	}
+	n, err := parseDigits(s[:i], 10, max, underscoresAnywhere)
+	if err == ErrSyntax || i == len(s) {
+		return n, err
+	}
+	overflow := err == ErrOverflow
+	for s = s[i:]; len(s) > 0; {
+		// Every component must match :[0-5]?[0-9]
+		var d uint64
+		switch {
+		case len(s) > 2 && s[1] >= '0' && s[1] <= '5' &&
+			s[2] >= '0' && s[2] <= '9' && (len(s) == 3 || s[3] == ':'):
+			d = uint64(s[1]-'0')*10 + uint64(s[2]-'0')
+			s = s[3:]
+		case len(s) > 1 && s[1] >= '0' && s[1] <= '9' &&
+			(len(s) == 2 || s[2] == ':'):
+			d = uint64(s[1] - '0')
+			s = s[2:]
+		default:
+			return 0, ErrSyntax
+		}
+		if n > (max-d)/60 {
+			overflow = true
+		}
+		n = n*60 + d
+	}
+	if overflow {
+		return 0, ErrOverflow
+	}
+	return n, nil
+}
@@ -0,0 +1,207 @@
+package parseint_test
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/romshark/parseint"
+	"github.com/stretchr/testify/require"
+)
+
+type yamlIntResult struct {
+	Value int64
+	IsInt bool
+	Err   error
+}
+
+var yaml12Int64 = map[string]yamlIntResult{
+	// YAML 1.2.2 spec, example 10.8 "JSON Tagged Scalars"
+	// and section 10.3.2 "Tag Resolution" of the core schema.
+	"0":      {0, true, nil},
+	"12":     {12, true, nil},
+	"-19":    {-19, true, nil},
+	"+12345": {12345, true, nil},
+	"0o14":   {0o14, true, nil},
+	"0xC":    {0xc, true, nil},
+	"0x1C":   {0x1c, true, nil},
+	"0o7":    {7, true, nil},
+	"0x3A":   {0x3a, true, nil},
+	"-0":     {0, true, nil},
+	"+0":     {0, true, nil},
+	"007":    {7, true, nil},
+	"0o0":    {0, true, nil},
+	"0x0":    {0, true, nil},
+	"0xff":   {0xff, true, nil},
+	"0xFf":   {0xff, true, nil},
+
+	"9223372036854775807":     {math.MaxInt64, true, nil},
+	"-9223372036854775808":    {math.MinInt64, true, nil},
+	"0x7fffffffffffffff":      {math.MaxInt64, true, nil},
+	"0x00007fffffffffffffff":  {math.MaxInt64, true, nil},
+	"0o777777777777777777777": {math.MaxInt64, true, nil},
+	"0x123456789":             {0x123456789, true, nil},
+
+	"9223372036854775808":         {0, true, parseint.ErrOverflow},
+	"-9223372036854775809":        {0, true, parseint.ErrOverflow},
+	"0x8000000000000000":          {0, true, parseint.ErrOverflow},
+	"0xffffffffffffffffff":        {0, true, parseint.ErrOverflow},
+	"0o1000000000000000000000":    {0, true, parseint.ErrOverflow},
+	"0o7777777777777777777777777": {0, true, parseint.ErrOverflow},
+
+	"":                     {0, false, nil},
+	"-":                    {0, false, nil},
+	"+":                    {0, false, nil},
+	"0o":                   {0, false, nil},
+	"0x":                   {0, false, nil},
+	"0o8":                  {0, false, nil},
+	"0xg":                  {0, false, nil},
+	"-0o7":                 {0, false, nil},
+	"+0x7":                 {0, false, nil},
+	"0X1":                  {0, false, nil},
+	"0O1":                  {0, false, nil},
+	"0b1":                  {0, false, nil},
+	"1_000":                {0, false, nil},
+	"190:20:30":            {0, false, nil},
+	"1.0":                  {0, false, nil},
+	"1e3":                  {0, false, nil},
+	"true":                 {0, false, nil},
+	" 1":                   {0, false, nil},
+	"1 ":                   {0, false, nil},
+	"0xfffffffffffffffffz": {0, false, nil},
+}
+
+var yaml11Int64 = map[string]yamlIntResult{
+	// https://yaml.org/type/int.html
+	"685230":                     {685230, true, nil},
+	"+685_230":                   {685230, true, nil},
+	"02472256":                   {685230, true, nil},
+	"0x_0A_74_AE":                {685230, true, nil},
+	"0b1010_0111_0100_1010_1110": {685230, true, nil},
+	"190:20:30":                  {685230, true, nil},
+	"0":                          {0, true, nil},
+	"-0":                         {0, true, nil},
+	"+0":                         {0, true, nil},
+	"00":                         {0, true, nil},
+	"0_":                         {0, true, nil},
+	"0b_":                        {0, true, nil},
+	"0x_":                        {0, true, nil},
+	"-0b101":                     {-5, true, nil},
+	"+0x1f":                      {0x1f, true, nil},
+	"-0x1F":                      {-0x1f, true, nil},
+	"-017":                       {-0o17, true, nil},
+	"1__000_":                    {1000, true, nil},
+	"-190:20:30":                 {-685230, true, nil},
+	"1:0":                        {60, true, nil},
+	"1:00":                       {60, true, nil},
+	"1:59":                       {119, true, nil},
+	"1:1:1":                      {3661, true, nil},
+	"1_0:0":                      {600, true, nil},
+	"9223372036854775807":        {math.MaxInt64, true, nil},
+	"-9223372036854775808":       {math.MinInt64, true, nil},
+	"9_223_372_036_854_775_807":  {math.MaxInt64, true, nil},
+	"0x7FFF_FFFF_FFFF_FFFF":      {math.MaxInt64, true, nil},
+	"-0x8000_0000_0000_0000":     {math.MinInt64, true, nil},
+	"2562047788015215:30:7":      {math.MaxInt64, true, nil},
+	"9223372036854775808":        {0, true, parseint.ErrOverflow},
+	"-9223372036854775809":       {0, true, parseint.ErrOverflow},
+	"0x8000_0000_0000_0000":      {0, true, parseint.ErrOverflow},
+	"0b1_0000000000000000000000000000000000000000000000000000000000000000": {
+		0, true, parseint.ErrOverflow,
+	},
+	"2562047788015215:30:8":     {0, true, parseint.ErrOverflow},
+	"99999999999999999999:30:8": {0, true, parseint.ErrOverflow},
+
+	"":                        {0, false, nil},
+	"-":                       {0, false, nil},
+	"+":                       {0, false, nil},
+	"_1":                      {0, false, nil},
+	"0b":                      {0, false, nil},
+	"0x":                      {0, false, nil},
+	"0b2":                     {0, false, nil},
+	"08":                      {0, false, nil},
+	"09":                      {0, false, nil},
+	"0xg":                     {0, false, nil},
+	"0o17":                    {0, false, nil},
+	"0X1":                     {0, false, nil},
+	"0B1":                     {0, false, nil},
+	"1:60":                    {0, false, nil},
+	"1:123":                   {0, false, nil},
+	"1:":                      {0, false, nil},
+	"1::0":                    {0, false, nil},
+	"1:_0":                    {0, false, nil},
+	"0:10":                    {0, false, nil},
+	"01:10":                   {0, false, nil},
+	"1.0":                     {0, false, nil},
+	"1e3":                     {0, false, nil},
+	"--1":                     {0, false, nil},
+	"yes":                     {0, false, nil},
+	"1 ":                      {0, false, nil},
+	"99999999999999999999:99": {0, false, nil},
+}
+
+func TestYAML12Int64(t *testing.T) {
+	for input, expect := range yaml12Int64 {
+		for _, r := range []yamlIntResult{
+			yamlResultOf(parseint.YAML12Int64(input)),
+			yamlResultOf(parseint.YAML12Int64([]byte(input))),
+		} {
+			require.Equal(t, expect, r, "%q", input)
+		}
+	}
+}
+
+func TestYAML11Int64(t *testing.T) {
+	for input, expect := range yaml11Int64 {
+		for _, r := range []yamlIntResult{
+			yamlResultOf(parseint.YAML11Int64(input)),
+			yamlResultOf(parseint.YAML11Int64([]byte(input))),
+		} {
+			require.Equal(t, expect, r, "%q", input)
+		}
+	}
+}
+
+func yamlResultOf(v int64, isInt bool, err error) yamlIntResult {
+	return yamlIntResult{v, isInt, err}
+}
+
+var yaml12IntRegexp = regexp.MustCompile(`^([-+]?[0-9]+|0o[0-7]+|0x[0-9a-fA-F]+)$`)
+
+func FuzzYAML12Int64(f *testing.F) {
+	for input := range yaml12Int64 {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		v, isInt, err := parseint.YAML12Int64(s)
+		if isInt != yaml12IntRegexp.MatchString(s) {
+			t.Fatalf("%q: unexpected isInt: %t", s, isInt)
+		}
+		if !isInt {
+			if v != 0 || err != nil {
+				t.Fatalf("%q: unexpected result for non-int: %d, %v", s, v, err)
+			}
+			return
+		}
+		var std int64
+		var errStd error
+		switch {
+		case strings.HasPrefix(s, "0o"):
+			std, errStd = strconv.ParseInt(s[2:], 8, 64)
+		case strings.HasPrefix(s, "0x"):
+			std, errStd = strconv.ParseInt(s[2:], 16, 64)
+		default:
+			std, errStd = strconv.ParseInt(s, 10, 64)
+		}
+		if errStd != nil {
+			if err != parseint.ErrOverflow {
+				t.Fatalf("%q: expected overflow; received: %v", s, err)
+			}
+		} else if err != nil || std != v {
+			t.Fatalf("%q: expected %d; received: %d, %v", s, std, v, err)
+		}
+	})
+}